@@ -31,8 +31,10 @@ provider "chatbotkit" {
 # Stores the agent's backstory which can be read and updated dynamically
 
 resource "chatbotkit_file" "backstory" {
-  name        = "Backstory"
-  description = "Incorporates narrative backstory elements that enrich the overall context."
+  name         = "Backstory"
+  description  = "Incorporates narrative backstory elements that enrich the overall context."
+  content      = "You are a helpful assistant. Refine this backstory as you learn more about the user."
+  content_type = "text/plain"
 }
 
 # ============================================================================
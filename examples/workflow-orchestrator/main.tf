@@ -10,6 +10,7 @@
 # - Three specialized workflow skillsets (Data, Control, Reporting)
 # - Space for persistent workflow state and traces
 # - Trigger integration for workflow execution
+# - Scheduled task for recurring workflow runs
 #
 # Prerequisites:
 # - Set the CHATBOTKIT_API_KEY environment variable
@@ -279,6 +280,19 @@ resource "chatbotkit_trigger_integration" "workflow_trigger" {
   trigger_schedule  = "never"
 }
 
+# ============================================================================
+# Scheduled Workflow Task
+# ============================================================================
+# Nightly run of the data quality workflow
+
+resource "chatbotkit_task" "nightly_data_quality" {
+  bot_id           = chatbotkit_bot.orchestrator.id
+  name             = "Nightly Data Quality Workflow"
+  description      = "Run the data quality workflow against yesterday's data and store the trace in the workspace"
+  schedule         = "daily"
+  session_duration = 3600000
+}
+
 # ============================================================================
 # Outputs
 # ============================================================================
@@ -312,3 +326,8 @@ output "workspace_id" {
   description = "The ID of the workflow workspace"
   value       = chatbotkit_space.workflow_workspace.id
 }
+
+output "nightly_task_status" {
+  description = "The status of the nightly data quality task"
+  value       = chatbotkit_task.nightly_data_quality.status
+}
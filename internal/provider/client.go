@@ -6,8 +6,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
+	"net/textproto"
+	"net/url"
 	"os"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -99,6 +103,132 @@ func (c *Client) doRequest(ctx context.Context, query string, variables map[stri
 	return nil
 }
 
+// doRESTRequest executes a JSON request against the REST API. Some operations,
+// such as file uploads and dataset file attachments, are not exposed through
+// GraphQL.
+func (c *Client) doRESTRequest(ctx context.Context, method string, endpoint string, body interface{}, result interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		bodyBytes, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request: %w", err)
+		}
+		reqBody = bytes.NewReader(bodyBytes)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.restURL(endpoint), reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Authorization", "Bearer "+c.APIKey)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+
+	return decodeRESTResponse(resp, result)
+}
+
+// decodeRESTResponse closes the response body and decodes it into result.
+// Non-2xx responses are returned as errors carrying the response body.
+func decodeRESTResponse(resp *http.Response, result interface{}) error {
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("request failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	if result != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, result); err != nil {
+			return fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// restItem is implemented by REST list items so that listREST can page
+// through them using the ID of the last item as the cursor.
+type restItem interface {
+	itemID() *string
+}
+
+// listREST walks every page of a REST list endpoint in ascending order and
+// returns all of its items.
+func listREST[T any, PT interface {
+	*T
+	restItem
+}](ctx context.Context, c *Client, endpoint string) ([]*T, error) {
+	var items []*T
+
+	err := walkREST[T, PT](ctx, c, endpoint, "asc", func(item *T) bool {
+		items = append(items, item)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// walkREST walks the pages of a REST list endpoint in the given order, "asc"
+// or "desc" by creation time, and passes every item to visit, stopping early
+// when visit returns false.
+func walkREST[T any, PT interface {
+	*T
+	restItem
+}](ctx context.Context, c *Client, endpoint string, order string, visit func(*T) bool) error {
+	cursor := ""
+	for {
+		query := url.Values{}
+		query.Set("order", order)
+		query.Set("take", fmt.Sprint(connectionPageSize))
+		if cursor != "" {
+			query.Set("cursor", cursor)
+		}
+
+		var response struct {
+			Items []*T `json:"items"`
+		}
+		if err := c.doRESTRequest(ctx, "GET", endpoint+"?"+query.Encode(), nil, &response); err != nil {
+			return err
+		}
+
+		for _, item := range response.Items {
+			if item != nil && !visit(item) {
+				return nil
+			}
+		}
+
+		if len(response.Items) < connectionPageSize {
+			return nil
+		}
+
+		last := response.Items[len(response.Items)-1]
+		if last == nil || PT(last).itemID() == nil || *PT(last).itemID() == cursor {
+			return nil
+		}
+		cursor = *PT(last).itemID()
+	}
+}
+
+// restURL returns the URL of a REST API endpoint. The REST API is served next
+// to the GraphQL endpoint, so the trailing /graphql is replaced by /v1.
+func (c *Client) restURL(endpoint string) string {
+	return strings.TrimSuffix(strings.TrimSuffix(c.BaseURL, "/"), "/graphql") + "/v1" + endpoint
+}
+
 // convertMapToInterface converts types.Map to map[string]interface{}.
 func convertMapToInterface(ctx context.Context, m types.Map) map[string]interface{} {
 	if m.IsNull() || m.IsUnknown() {
@@ -109,6 +239,116 @@ func convertMapToInterface(ctx context.Context, m types.Map) map[string]interfac
 	return result
 }
 
+// nullUnknownStrings replaces unknown string values with null. Read-only
+// attributes are planned as unknown, and Terraform rejects unknown values in
+// the state returned after apply, so they are left empty until the next read.
+func nullUnknownStrings(values ...*types.String) {
+	for _, v := range values {
+		if v.IsUnknown() {
+			*v = types.StringNull()
+		}
+	}
+}
+
+// connectionPageSize is the number of nodes requested per page when walking
+// a GraphQL connection.
+const connectionPageSize = 100
+
+// Connection represents a single page of a GraphQL connection.
+type Connection[T any] struct {
+	Edges []struct {
+		Cursor *string `json:"cursor,omitempty"`
+		Node   *T      `json:"node"`
+	} `json:"edges"`
+	PageInfo *struct {
+		HasNextPage bool    `json:"hasNextPage"`
+		EndCursor   *string `json:"endCursor,omitempty"`
+	} `json:"pageInfo,omitempty"`
+}
+
+// listConnection walks every page of the top-level connection named field and
+// returns all of its nodes. The query must accept `$first: Int` and
+// `$cursor: ID` variables and select `edges { cursor node }` and
+// `pageInfo { hasNextPage endCursor }` on the connection.
+func listConnection[T any](ctx context.Context, c *Client, query string, field string, variables map[string]interface{}) ([]*T, error) {
+	var nodes []*T
+
+	err := walkConnection(ctx, c, query, variables,
+		func(response map[string]*Connection[T]) (*Connection[T], error) {
+			return response[field], nil
+		},
+		func(node *T) bool {
+			nodes = append(nodes, node)
+			return true
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
+// walkConnection walks the pages of a connection and passes every node to
+// visit, stopping early when visit returns false. extract picks the
+// connection out of each response, which allows connections nested below
+// other fields; it returns nil when there are no more nodes. The query must
+// accept `$first: Int` and `$cursor: ID` variables for the connection and
+// select `edges { cursor node }` and `pageInfo { hasNextPage endCursor }` on
+// it.
+func walkConnection[T any, R any](ctx context.Context, c *Client, query string, variables map[string]interface{}, extract func(R) (*Connection[T], error), visit func(*T) bool) error {
+	vars := map[string]interface{}{
+		"first": connectionPageSize,
+	}
+	for k, v := range variables {
+		vars[k] = v
+	}
+
+	seen := map[string]bool{}
+
+	for {
+		var response R
+
+		if err := c.doRequest(ctx, query, vars, &response); err != nil {
+			return err
+		}
+
+		conn, err := extract(response)
+		if err != nil {
+			return err
+		}
+		if conn == nil {
+			return nil
+		}
+
+		var cursor *string
+		for _, edge := range conn.Edges {
+			if edge.Node != nil && !visit(edge.Node) {
+				return nil
+			}
+			if edge.Cursor != nil {
+				cursor = edge.Cursor
+			}
+		}
+
+		if conn.PageInfo == nil || !conn.PageInfo.HasNextPage || len(conn.Edges) == 0 {
+			return nil
+		}
+		if conn.PageInfo.EndCursor != nil {
+			cursor = conn.PageInfo.EndCursor
+		}
+
+		// Stop rather than loop forever if the API hands back a cursor we
+		// have already followed
+		if cursor == nil || seen[*cursor] {
+			return nil
+		}
+		seen[*cursor] = true
+
+		vars["cursor"] = *cursor
+	}
+}
+
 
 // CreateBlueprintInput represents the input for creating a blueprint.
 type CreateBlueprintInput struct {
@@ -230,11 +470,28 @@ type GetBlueprintResponse struct {
 
 // GetBlueprint fetches a blueprint by ID.
 func (c *Client) GetBlueprint(ctx context.Context, id string) (*GetBlueprintResponse, error) {
-	// Note: The GraphQL API uses connection-based queries, so we filter by ID
+	// The GraphQL API has no lookup by ID, so walk the connection
+	items, err := c.ListBlueprints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item != nil && item.ID != nil && *item.ID == id {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("blueprint with ID %s not found", id)
+}
+
+// ListBlueprints lists all blueprints.
+func (c *Client) ListBlueprints(ctx context.Context) ([]*GetBlueprintResponse, error) {
 	query := `
-		query GetBlueprint($cursor: ID) {
-			blueprints(first: 1, after: $cursor) {
+		query ListBlueprints($first: Int, $cursor: ID) {
+			blueprints(first: $first, after: $cursor) {
 				edges {
+					cursor
 					node {
 						id
 						description
@@ -245,34 +502,15 @@ func (c *Client) GetBlueprint(ctx context.Context, id string) (*GetBlueprintResp
 						updatedAt
 					}
 				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
 			}
 		}
 	`
 
-	// For read operations, we need to iterate through results to find by ID
-	// This is a simplified implementation - in production, you'd want proper pagination
-	variables := map[string]interface{}{}
-
-	var response struct {
-		Blueprints struct {
-			Edges []struct {
-				Node *GetBlueprintResponse `json:"node"`
-			} `json:"edges"`
-		} `json:"blueprints"`
-	}
-
-	if err := c.doRequest(ctx, query, variables, &response); err != nil {
-		return nil, err
-	}
-
-	// Find the resource with matching ID
-	for _, edge := range response.Blueprints.Edges {
-		if edge.Node != nil && edge.Node.ID != nil && *edge.Node.ID == id {
-			return edge.Node, nil
-		}
-	}
-
-	return nil, fmt.Errorf("blueprint with ID %s not found", id)
+	return listConnection[GetBlueprintResponse](ctx, c, query, "blueprints", nil)
 }
 
 
@@ -417,11 +655,28 @@ type GetBotResponse struct {
 
 // GetBot fetches a bot by ID.
 func (c *Client) GetBot(ctx context.Context, id string) (*GetBotResponse, error) {
-	// Note: The GraphQL API uses connection-based queries, so we filter by ID
+	// The GraphQL API has no lookup by ID, so walk the connection
+	items, err := c.ListBots(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item != nil && item.ID != nil && *item.ID == id {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("bot with ID %s not found", id)
+}
+
+// ListBots lists all bots.
+func (c *Client) ListBots(ctx context.Context) ([]*GetBotResponse, error) {
 	query := `
-		query GetBot($cursor: ID) {
-			bots(first: 1, after: $cursor) {
+		query ListBots($first: Int, $cursor: ID) {
+			bots(first: $first, after: $cursor) {
 				edges {
+					cursor
 					node {
 						id
 						backstory
@@ -439,36 +694,310 @@ func (c *Client) GetBot(ctx context.Context, id string) (*GetBotResponse, error)
 						updatedAt
 					}
 				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
 			}
 		}
 	`
 
-	// For read operations, we need to iterate through results to find by ID
-	// This is a simplified implementation - in production, you'd want proper pagination
-	variables := map[string]interface{}{}
+	return listConnection[GetBotResponse](ctx, c, query, "bots", nil)
+}
+
 
-	var response struct {
-		Bots struct {
-			Edges []struct {
-				Node *GetBotResponse `json:"node"`
-			} `json:"edges"`
-		} `json:"bots"`
+// CreateContactInput represents the input for creating a contact.
+type CreateContactInput struct {
+	Description *string `json:"description,omitempty"`
+	Email *string `json:"email,omitempty"`
+	Fingerprint *string `json:"fingerprint,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Nick *string `json:"nick,omitempty"`
+	Phone *string `json:"phone,omitempty"`
+}
+
+// CreateContactResponse represents the response from creating a contact.
+type CreateContactResponse struct {
+	ID *string `json:"id"`
+}
+
+// CreateContact creates a new contact.
+func (c *Client) CreateContact(ctx context.Context, input CreateContactInput) (*CreateContactResponse, error) {
+	var response CreateContactResponse
+	if err := c.doRESTRequest(ctx, "POST", "/contact/create", input, &response); err != nil {
+		return nil, err
 	}
 
-	if err := c.doRequest(ctx, query, variables, &response); err != nil {
+	return &response, nil
+}
+
+// UpdateContactInput represents the input for updating a contact.
+type UpdateContactInput struct {
+	Description *string `json:"description,omitempty"`
+	Email *string `json:"email,omitempty"`
+	Fingerprint *string `json:"fingerprint,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Nick *string `json:"nick,omitempty"`
+	Phone *string `json:"phone,omitempty"`
+}
+
+// UpdateContactResponse represents the response from updating a contact.
+type UpdateContactResponse struct {
+	ID *string `json:"id"`
+}
+
+// UpdateContact updates an existing contact.
+func (c *Client) UpdateContact(ctx context.Context, id string, input UpdateContactInput) (*UpdateContactResponse, error) {
+	var response UpdateContactResponse
+	if err := c.doRESTRequest(ctx, "POST", "/contact/"+url.PathEscape(id)+"/update", input, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// DeleteContactResponse represents the response from deleting a contact.
+type DeleteContactResponse struct {
+	ID *string `json:"id"`
+}
+
+// DeleteContact deletes a contact.
+func (c *Client) DeleteContact(ctx context.Context, id string) (*DeleteContactResponse, error) {
+	var response DeleteContactResponse
+	if err := c.doRESTRequest(ctx, "POST", "/contact/"+url.PathEscape(id)+"/delete", map[string]interface{}{}, &response); err != nil {
 		return nil, err
 	}
 
-	// Find the resource with matching ID
-	for _, edge := range response.Bots.Edges {
-		if edge.Node != nil && edge.Node.ID != nil && *edge.Node.ID == id {
-			return edge.Node, nil
+	return &response, nil
+}
+
+// GetContactResponse represents the response from fetching a contact.
+type GetContactResponse struct {
+	ID *string `json:"id"`
+	Description *string `json:"description,omitempty"`
+	Email *string `json:"email,omitempty"`
+	Fingerprint *string `json:"fingerprint,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Nick *string `json:"nick,omitempty"`
+	Phone *string `json:"phone,omitempty"`
+	VerifiedAt *string `json:"verifiedAt,omitempty"`
+	CreatedAt *string `json:"createdAt,omitempty"`
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+}
+
+// itemID returns the list cursor of a contact.
+func (r *GetContactResponse) itemID() *string {
+	return r.ID
+}
+
+// ListContacts fetches every contact in the account, oldest first.
+func (c *Client) ListContacts(ctx context.Context) ([]*GetContactResponse, error) {
+	return listREST[GetContactResponse](ctx, c, "/contact/list")
+}
+
+// GetContact fetches a contact by ID.
+func (c *Client) GetContact(ctx context.Context, id string) (*GetContactResponse, error) {
+	var response GetContactResponse
+	if err := c.doRESTRequest(ctx, "GET", "/contact/"+url.PathEscape(id)+"/fetch", nil, &response); err != nil {
+		if strings.Contains(err.Error(), "status 404") {
+			return nil, fmt.Errorf("contact with ID %s not found", id)
 		}
+		return nil, err
 	}
 
-	return nil, fmt.Errorf("bot with ID %s not found", id)
+	return &response, nil
+}
+
+
+// ConversationMessageInput represents a message of a conversation.
+type ConversationMessageInput struct {
+	Type *string `json:"type,omitempty"`
+	Text *string `json:"text,omitempty"`
+}
+
+// CreateConversationInput represents the input for creating a conversation.
+type CreateConversationInput struct {
+	BotId *string `json:"botId,omitempty"`
+	ContactId *string `json:"contactId,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	Name *string `json:"name,omitempty"`
+	SpaceId *string `json:"spaceId,omitempty"`
+}
+
+// CreateConversationResponse represents the response from creating a conversation.
+type CreateConversationResponse struct {
+	ID *string `json:"id"`
+}
+
+// CreateConversation creates a new, empty conversation. Messages are added
+// separately with CreateConversationMessage.
+func (c *Client) CreateConversation(ctx context.Context, input CreateConversationInput) (*CreateConversationResponse, error) {
+	var response CreateConversationResponse
+	if err := c.doRESTRequest(ctx, "POST", "/conversation/create", input, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// UpdateConversationInput represents the input for updating a conversation.
+type UpdateConversationInput struct {
+	BotId *string `json:"botId,omitempty"`
+	ContactId *string `json:"contactId,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	Name *string `json:"name,omitempty"`
+	SpaceId *string `json:"spaceId,omitempty"`
+}
+
+// UpdateConversationResponse represents the response from updating a conversation.
+type UpdateConversationResponse struct {
+	ID *string `json:"id"`
+}
+
+// UpdateConversation updates an existing conversation.
+func (c *Client) UpdateConversation(ctx context.Context, id string, input UpdateConversationInput) (*UpdateConversationResponse, error) {
+	var response UpdateConversationResponse
+	if err := c.doRESTRequest(ctx, "POST", "/conversation/"+url.PathEscape(id)+"/update", input, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// DeleteConversationResponse represents the response from deleting a conversation.
+type DeleteConversationResponse struct {
+	ID *string `json:"id"`
+}
+
+// DeleteConversation deletes a conversation and its messages.
+func (c *Client) DeleteConversation(ctx context.Context, id string) (*DeleteConversationResponse, error) {
+	var response DeleteConversationResponse
+	if err := c.doRESTRequest(ctx, "POST", "/conversation/"+url.PathEscape(id)+"/delete", map[string]interface{}{}, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetConversationResponse represents the response from fetching a conversation.
+type GetConversationResponse struct {
+	ID *string `json:"id"`
+	BotId *string `json:"botId,omitempty"`
+	ContactId *string `json:"contactId,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	Name *string `json:"name,omitempty"`
+	SpaceId *string `json:"spaceId,omitempty"`
+	TaskId *string `json:"taskId,omitempty"`
+	CreatedAt *string `json:"createdAt,omitempty"`
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+}
+
+// itemID returns the list cursor of a conversation.
+func (r *GetConversationResponse) itemID() *string {
+	return r.ID
+}
+
+// ListConversations walks the conversations of the account, most recent
+// first, and passes every conversation to visit until it returns false.
+func (c *Client) ListConversations(ctx context.Context, visit func(*GetConversationResponse) bool) error {
+	return walkREST[GetConversationResponse](ctx, c, "/conversation/list", "desc", visit)
+}
+
+// GetConversation fetches a conversation by ID.
+func (c *Client) GetConversation(ctx context.Context, id string) (*GetConversationResponse, error) {
+	var response GetConversationResponse
+	if err := c.doRESTRequest(ctx, "GET", "/conversation/"+url.PathEscape(id)+"/fetch", nil, &response); err != nil {
+		if strings.Contains(err.Error(), "status 404") {
+			return nil, fmt.Errorf("conversation with ID %s not found", id)
+		}
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// ConversationMessageResponse represents a message of a conversation.
+type ConversationMessageResponse struct {
+	ID *string `json:"id"`
+	Type *string `json:"type,omitempty"`
+	Text *string `json:"text,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	CreatedAt *string `json:"createdAt,omitempty"`
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+}
+
+// itemID returns the list cursor of a conversation message.
+func (r *ConversationMessageResponse) itemID() *string {
+	return r.ID
+}
+
+// ListConversationMessages lists the messages of a conversation, oldest first.
+func (c *Client) ListConversationMessages(ctx context.Context, conversationId string) ([]*ConversationMessageResponse, error) {
+	return listREST[ConversationMessageResponse](ctx, c, "/conversation/"+url.PathEscape(conversationId)+"/message/list")
+}
+
+// CreateConversationMessage appends a message to a conversation.
+func (c *Client) CreateConversationMessage(ctx context.Context, conversationId string, input ConversationMessageInput) (*ConversationMessageResponse, error) {
+	var response ConversationMessageResponse
+	if err := c.doRESTRequest(ctx, "POST", "/conversation/"+url.PathEscape(conversationId)+"/message/create", input, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
 }
 
+// UpdateConversationMessage updates a message of a conversation in place.
+func (c *Client) UpdateConversationMessage(ctx context.Context, conversationId string, messageId string, input ConversationMessageInput) (*ConversationMessageResponse, error) {
+	var response ConversationMessageResponse
+	if err := c.doRESTRequest(ctx, "POST", "/conversation/"+url.PathEscape(conversationId)+"/message/"+url.PathEscape(messageId)+"/update", input, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// DeleteConversationMessage deletes a message of a conversation.
+func (c *Client) DeleteConversationMessage(ctx context.Context, conversationId string, messageId string) (*ConversationMessageResponse, error) {
+	var response ConversationMessageResponse
+	if err := c.doRESTRequest(ctx, "POST", "/conversation/"+url.PathEscape(conversationId)+"/message/"+url.PathEscape(messageId)+"/delete", map[string]interface{}{}, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// RatingResponse represents a rating of a conversation or message.
+type RatingResponse struct {
+	ID *string `json:"id"`
+	Value *int64 `json:"value,omitempty"`
+	Reason *string `json:"reason,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	BotId *string `json:"botId,omitempty"`
+	ContactId *string `json:"contactId,omitempty"`
+	ConversationId *string `json:"conversationId,omitempty"`
+	MessageId *string `json:"messageId,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	CreatedAt *string `json:"createdAt,omitempty"`
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+}
+
+// itemID returns the list cursor of a rating.
+func (r *RatingResponse) itemID() *string {
+	return r.ID
+}
+
+// ListRatings walks the ratings of the account, most recent first, and
+// passes every rating to visit until it returns false.
+func (c *Client) ListRatings(ctx context.Context, visit func(*RatingResponse) bool) error {
+	return walkREST[RatingResponse](ctx, c, "/rating/list", "desc", visit)
+}
 
 // CreateDatasetInput represents the input for creating a dataset.
 type CreateDatasetInput struct {
@@ -620,11 +1149,28 @@ type GetDatasetResponse struct {
 
 // GetDataset fetches a dataset by ID.
 func (c *Client) GetDataset(ctx context.Context, id string) (*GetDatasetResponse, error) {
-	// Note: The GraphQL API uses connection-based queries, so we filter by ID
+	// The GraphQL API has no lookup by ID, so walk the connection
+	items, err := c.ListDatasets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item != nil && item.ID != nil && *item.ID == id {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("dataset with ID %s not found", id)
+}
+
+// ListDatasets lists all datasets.
+func (c *Client) ListDatasets(ctx context.Context) ([]*GetDatasetResponse, error) {
 	query := `
-		query GetDataset($cursor: ID) {
-			datasets(first: 1, after: $cursor) {
+		query ListDatasets($first: Int, $cursor: ID) {
+			datasets(first: $first, after: $cursor) {
 				edges {
+					cursor
 					node {
 						id
 						blueprintId
@@ -645,36 +1191,128 @@ func (c *Client) GetDataset(ctx context.Context, id string) (*GetDatasetResponse
 						updatedAt
 					}
 				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
 			}
 		}
 	`
 
-	// For read operations, we need to iterate through results to find by ID
-	// This is a simplified implementation - in production, you'd want proper pagination
-	variables := map[string]interface{}{}
+	return listConnection[GetDatasetResponse](ctx, c, query, "datasets", nil)
+}
 
-	var response struct {
-		Datasets struct {
-			Edges []struct {
-				Node *GetDatasetResponse `json:"node"`
-			} `json:"edges"`
-		} `json:"datasets"`
+
+// AttachDatasetFileInput represents the input for attaching a file to a
+// dataset.
+type AttachDatasetFileInput struct {
+	Type *string `json:"type,omitempty"`
+}
+
+// DatasetFileResponse represents the response from a dataset file operation.
+type DatasetFileResponse struct {
+	ID *string `json:"id"`
+}
+
+// AttachDatasetFile attaches a file to a dataset.
+func (c *Client) AttachDatasetFile(ctx context.Context, datasetId string, fileId string, input AttachDatasetFileInput) (*DatasetFileResponse, error) {
+	var response DatasetFileResponse
+	if err := c.doRESTRequest(ctx, "POST", datasetFileEndpoint(datasetId, fileId, "attach"), input, &response); err != nil {
+		return nil, err
 	}
 
-	if err := c.doRequest(ctx, query, variables, &response); err != nil {
+	return &response, nil
+}
+
+// DetachDatasetFile detaches a file from a dataset. The file itself is kept.
+func (c *Client) DetachDatasetFile(ctx context.Context, datasetId string, fileId string) (*DatasetFileResponse, error) {
+	var response DatasetFileResponse
+	if err := c.doRESTRequest(ctx, "POST", datasetFileEndpoint(datasetId, fileId, "detach"), map[string]interface{}{}, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// SyncDatasetFile triggers ingestion of an attached file into the dataset.
+func (c *Client) SyncDatasetFile(ctx context.Context, datasetId string, fileId string) (*DatasetFileResponse, error) {
+	var response DatasetFileResponse
+	if err := c.doRESTRequest(ctx, "POST", datasetFileEndpoint(datasetId, fileId, "sync"), map[string]interface{}{}, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetDatasetFileResponse represents a file attached to a dataset.
+type GetDatasetFileResponse struct {
+	ID *string `json:"id"`
+	Name *string `json:"name,omitempty"`
+	Type *string `json:"type,omitempty"`
+	SyncStatus *string `json:"syncStatus,omitempty"`
+	CreatedAt *string `json:"createdAt,omitempty"`
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+}
+
+// itemID returns the list cursor of a dataset file.
+func (r *GetDatasetFileResponse) itemID() *string {
+	return r.ID
+}
+
+// ListDatasetFiles lists all files attached to a dataset.
+func (c *Client) ListDatasetFiles(ctx context.Context, datasetId string) ([]*GetDatasetFileResponse, error) {
+	return listREST[GetDatasetFileResponse](ctx, c, "/dataset/"+url.PathEscape(datasetId)+"/file/list")
+}
+
+// GetDatasetFile fetches a file attached to a dataset.
+func (c *Client) GetDatasetFile(ctx context.Context, datasetId string, fileId string) (*GetDatasetFileResponse, error) {
+	files, err := c.ListDatasetFiles(ctx, datasetId)
+	if err != nil {
 		return nil, err
 	}
 
-	// Find the resource with matching ID
-	for _, edge := range response.Datasets.Edges {
-		if edge.Node != nil && edge.Node.ID != nil && *edge.Node.ID == id {
-			return edge.Node, nil
+	for _, file := range files {
+		if file != nil && file.ID != nil && *file.ID == fileId {
+			return file, nil
 		}
 	}
 
-	return nil, fmt.Errorf("dataset with ID %s not found", id)
+	return nil, fmt.Errorf("file with ID %s not found in dataset %s", fileId, datasetId)
+}
+
+// SearchDatasetInput represents the input for searching a dataset.
+type SearchDatasetInput struct {
+	Search *string `json:"search"`
+}
+
+// DatasetSearchRecordResponse represents a dataset record matched by a search.
+type DatasetSearchRecordResponse struct {
+	ID *string `json:"id"`
+	Text *string `json:"text,omitempty"`
+	Score *float64 `json:"score,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	CreatedAt *string `json:"createdAt,omitempty"`
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+}
+
+// SearchDataset searches the records of a dataset using the retrieval
+// settings of the dataset.
+func (c *Client) SearchDataset(ctx context.Context, datasetId string, input SearchDatasetInput) ([]*DatasetSearchRecordResponse, error) {
+	var response struct {
+		Records []*DatasetSearchRecordResponse `json:"records"`
+	}
+	if err := c.doRESTRequest(ctx, "POST", "/dataset/"+url.PathEscape(datasetId)+"/search", input, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Records, nil
 }
 
+// datasetFileEndpoint returns the REST endpoint for an operation on a file
+// attached to a dataset.
+func datasetFileEndpoint(datasetId string, fileId string, operation string) string {
+	return "/dataset/" + url.PathEscape(datasetId) + "/file/" + url.PathEscape(fileId) + "/" + operation
+}
 
 // CreateDiscordIntegrationInput represents the input for creating a discordintegration.
 type CreateDiscordIntegrationInput struct {
@@ -817,11 +1455,28 @@ type GetDiscordIntegrationResponse struct {
 
 // GetDiscordIntegration fetches a discordintegration by ID.
 func (c *Client) GetDiscordIntegration(ctx context.Context, id string) (*GetDiscordIntegrationResponse, error) {
-	// Note: The GraphQL API uses connection-based queries, so we filter by ID
+	// The GraphQL API has no lookup by ID, so walk the connection
+	items, err := c.ListDiscordIntegrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item != nil && item.ID != nil && *item.ID == id {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("discordintegration with ID %s not found", id)
+}
+
+// ListDiscordIntegrations lists all discordIntegrations.
+func (c *Client) ListDiscordIntegrations(ctx context.Context) ([]*GetDiscordIntegrationResponse, error) {
 	query := `
-		query GetDiscordIntegration($cursor: ID) {
-			discordIntegrations(first: 1, after: $cursor) {
+		query ListDiscordIntegrations($first: Int, $cursor: ID) {
+			discordIntegrations(first: $first, after: $cursor) {
 				edges {
+					cursor
 					node {
 						id
 						appId
@@ -839,34 +1494,15 @@ func (c *Client) GetDiscordIntegration(ctx context.Context, id string) (*GetDisc
 						updatedAt
 					}
 				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
 			}
 		}
 	`
 
-	// For read operations, we need to iterate through results to find by ID
-	// This is a simplified implementation - in production, you'd want proper pagination
-	variables := map[string]interface{}{}
-
-	var response struct {
-		DiscordIntegrations struct {
-			Edges []struct {
-				Node *GetDiscordIntegrationResponse `json:"node"`
-			} `json:"edges"`
-		} `json:"discordIntegrations"`
-	}
-
-	if err := c.doRequest(ctx, query, variables, &response); err != nil {
-		return nil, err
-	}
-
-	// Find the resource with matching ID
-	for _, edge := range response.DiscordIntegrations.Edges {
-		if edge.Node != nil && edge.Node.ID != nil && *edge.Node.ID == id {
-			return edge.Node, nil
-		}
-	}
-
-	return nil, fmt.Errorf("discordintegration with ID %s not found", id)
+	return listConnection[GetDiscordIntegrationResponse](ctx, c, query, "discordIntegrations", nil)
 }
 
 
@@ -1002,11 +1638,28 @@ type GetEmailIntegrationResponse struct {
 
 // GetEmailIntegration fetches a emailintegration by ID.
 func (c *Client) GetEmailIntegration(ctx context.Context, id string) (*GetEmailIntegrationResponse, error) {
-	// Note: The GraphQL API uses connection-based queries, so we filter by ID
+	// The GraphQL API has no lookup by ID, so walk the connection
+	items, err := c.ListEmailIntegrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item != nil && item.ID != nil && *item.ID == id {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("emailintegration with ID %s not found", id)
+}
+
+// ListEmailIntegrations lists all emailIntegrations.
+func (c *Client) ListEmailIntegrations(ctx context.Context) ([]*GetEmailIntegrationResponse, error) {
 	query := `
-		query GetEmailIntegration($cursor: ID) {
-			emailIntegrations(first: 1, after: $cursor) {
+		query ListEmailIntegrations($first: Int, $cursor: ID) {
+			emailIntegrations(first: $first, after: $cursor) {
 				edges {
+					cursor
 					node {
 						id
 						attachments
@@ -1021,34 +1674,15 @@ func (c *Client) GetEmailIntegration(ctx context.Context, id string) (*GetEmailI
 						updatedAt
 					}
 				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
 			}
 		}
 	`
 
-	// For read operations, we need to iterate through results to find by ID
-	// This is a simplified implementation - in production, you'd want proper pagination
-	variables := map[string]interface{}{}
-
-	var response struct {
-		EmailIntegrations struct {
-			Edges []struct {
-				Node *GetEmailIntegrationResponse `json:"node"`
-			} `json:"edges"`
-		} `json:"emailIntegrations"`
-	}
-
-	if err := c.doRequest(ctx, query, variables, &response); err != nil {
-		return nil, err
-	}
-
-	// Find the resource with matching ID
-	for _, edge := range response.EmailIntegrations.Edges {
-		if edge.Node != nil && edge.Node.ID != nil && *edge.Node.ID == id {
-			return edge.Node, nil
-		}
-	}
-
-	return nil, fmt.Errorf("emailintegration with ID %s not found", id)
+	return listConnection[GetEmailIntegrationResponse](ctx, c, query, "emailIntegrations", nil)
 }
 
 
@@ -1181,11 +1815,28 @@ type GetExtractIntegrationResponse struct {
 
 // GetExtractIntegration fetches a extractintegration by ID.
 func (c *Client) GetExtractIntegration(ctx context.Context, id string) (*GetExtractIntegrationResponse, error) {
-	// Note: The GraphQL API uses connection-based queries, so we filter by ID
+	// The GraphQL API has no lookup by ID, so walk the connection
+	items, err := c.ListExtractIntegrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item != nil && item.ID != nil && *item.ID == id {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("extractintegration with ID %s not found", id)
+}
+
+// ListExtractIntegrations lists all extractIntegrations.
+func (c *Client) ListExtractIntegrations(ctx context.Context) ([]*GetExtractIntegrationResponse, error) {
 	query := `
-		query GetExtractIntegration($cursor: ID) {
-			extractIntegrations(first: 1, after: $cursor) {
+		query ListExtractIntegrations($first: Int, $cursor: ID) {
+			extractIntegrations(first: $first, after: $cursor) {
 				edges {
+					cursor
 					node {
 						id
 						blueprintId
@@ -1199,34 +1850,15 @@ func (c *Client) GetExtractIntegration(ctx context.Context, id string) (*GetExtr
 						updatedAt
 					}
 				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
 			}
 		}
 	`
 
-	// For read operations, we need to iterate through results to find by ID
-	// This is a simplified implementation - in production, you'd want proper pagination
-	variables := map[string]interface{}{}
-
-	var response struct {
-		ExtractIntegrations struct {
-			Edges []struct {
-				Node *GetExtractIntegrationResponse `json:"node"`
-			} `json:"edges"`
-		} `json:"extractIntegrations"`
-	}
-
-	if err := c.doRequest(ctx, query, variables, &response); err != nil {
-		return nil, err
-	}
-
-	// Find the resource with matching ID
-	for _, edge := range response.ExtractIntegrations.Edges {
-		if edge.Node != nil && edge.Node.ID != nil && *edge.Node.ID == id {
-			return edge.Node, nil
-		}
-	}
-
-	return nil, fmt.Errorf("extractintegration with ID %s not found", id)
+	return listConnection[GetExtractIntegrationResponse](ctx, c, query, "extractIntegrations", nil)
 }
 
 
@@ -1353,11 +1985,28 @@ type GetFileResponse struct {
 
 // GetFile fetches a file by ID.
 func (c *Client) GetFile(ctx context.Context, id string) (*GetFileResponse, error) {
-	// Note: The GraphQL API uses connection-based queries, so we filter by ID
+	// The GraphQL API has no lookup by ID, so walk the connection
+	items, err := c.ListFiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item != nil && item.ID != nil && *item.ID == id {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("file with ID %s not found", id)
+}
+
+// ListFiles lists all files.
+func (c *Client) ListFiles(ctx context.Context) ([]*GetFileResponse, error) {
 	query := `
-		query GetFile($cursor: ID) {
-			files(first: 1, after: $cursor) {
+		query ListFiles($first: Int, $cursor: ID) {
+			files(first: $first, after: $cursor) {
 				edges {
+					cursor
 					node {
 						id
 						blueprintId
@@ -1369,34 +2018,74 @@ func (c *Client) GetFile(ctx context.Context, id string) (*GetFileResponse, erro
 						updatedAt
 					}
 				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
 			}
 		}
 	`
 
-	// For read operations, we need to iterate through results to find by ID
-	// This is a simplified implementation - in production, you'd want proper pagination
-	variables := map[string]interface{}{}
+	return listConnection[GetFileResponse](ctx, c, query, "files", nil)
+}
 
-	var response struct {
-		Files struct {
-			Edges []struct {
-				Node *GetFileResponse `json:"node"`
-			} `json:"edges"`
-		} `json:"files"`
+// UploadFileInput describes the content to upload to a file.
+type UploadFileInput struct {
+	// Name is the file name reported in the multipart form.
+	Name string
+	// ContentType is the MIME type of the content.
+	ContentType string
+	// Body is streamed to the API and is read until EOF.
+	Body io.Reader
+}
+
+// UploadFileResponse represents the response from uploading file content.
+type UploadFileResponse struct {
+	ID *string `json:"id"`
+}
+
+// UploadFile streams content to an existing file. The body is written as a
+// multipart form through a pipe so that large files are never held in memory.
+func (c *Client) UploadFile(ctx context.Context, id string, input UploadFileInput) (*UploadFileResponse, error) {
+	pr, pw := io.Pipe()
+	defer pr.Close()
+
+	writer := multipart.NewWriter(pw)
+
+	go func() {
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename=%q`, input.Name))
+		header.Set("Content-Type", input.ContentType)
+
+		part, err := writer.CreatePart(header)
+		if err == nil {
+			_, err = io.Copy(part, input.Body)
+		}
+		if err == nil {
+			err = writer.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.restURL("/file/"+url.PathEscape(id)+"/upload"), pr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	if err := c.doRequest(ctx, query, variables, &response); err != nil {
-		return nil, err
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Authorization", "Bearer "+c.APIKey)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
 
-	// Find the resource with matching ID
-	for _, edge := range response.Files.Edges {
-		if edge.Node != nil && edge.Node.ID != nil && *edge.Node.ID == id {
-			return edge.Node, nil
-		}
+	var response UploadFileResponse
+	if err := decodeRESTResponse(resp, &response); err != nil {
+		return nil, err
 	}
 
-	return nil, fmt.Errorf("file with ID %s not found", id)
+	return &response, nil
 }
 
 
@@ -1523,11 +2212,28 @@ type GetMcpserverIntegrationResponse struct {
 
 // GetMcpserverIntegration fetches a mcpserverintegration by ID.
 func (c *Client) GetMcpserverIntegration(ctx context.Context, id string) (*GetMcpserverIntegrationResponse, error) {
-	// Note: The GraphQL API uses connection-based queries, so we filter by ID
+	// The GraphQL API has no lookup by ID, so walk the connection
+	items, err := c.ListMcpserverIntegrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item != nil && item.ID != nil && *item.ID == id {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("mcpserverintegration with ID %s not found", id)
+}
+
+// ListMcpserverIntegrations lists all mcpserverIntegrations.
+func (c *Client) ListMcpserverIntegrations(ctx context.Context) ([]*GetMcpserverIntegrationResponse, error) {
 	query := `
-		query GetMcpserverIntegration($cursor: ID) {
-			mcpserverIntegrations(first: 1, after: $cursor) {
+		query ListMcpserverIntegrations($first: Int, $cursor: ID) {
+			mcpserverIntegrations(first: $first, after: $cursor) {
 				edges {
+					cursor
 					node {
 						id
 						blueprintId
@@ -1539,34 +2245,113 @@ func (c *Client) GetMcpserverIntegration(ctx context.Context, id string) (*GetMc
 						updatedAt
 					}
 				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
 			}
 		}
 	`
 
-	// For read operations, we need to iterate through results to find by ID
-	// This is a simplified implementation - in production, you'd want proper pagination
-	variables := map[string]interface{}{}
+	return listConnection[GetMcpserverIntegrationResponse](ctx, c, query, "mcpserverIntegrations", nil)
+}
 
-	var response struct {
-		McpserverIntegrations struct {
-			Edges []struct {
-				Node *GetMcpserverIntegrationResponse `json:"node"`
-			} `json:"edges"`
-		} `json:"mcpserverIntegrations"`
+
+// CreateMemoryInput represents the input for creating a memory.
+type CreateMemoryInput struct {
+	BotId *string `json:"botId,omitempty"`
+	ContactId *string `json:"contactId,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Text *string `json:"text,omitempty"`
+}
+
+// CreateMemoryResponse represents the response from creating a memory.
+type CreateMemoryResponse struct {
+	ID *string `json:"id"`
+}
+
+// CreateMemory creates a new memory.
+func (c *Client) CreateMemory(ctx context.Context, input CreateMemoryInput) (*CreateMemoryResponse, error) {
+	var response CreateMemoryResponse
+	if err := c.doRESTRequest(ctx, "POST", "/memory/create", input, &response); err != nil {
+		return nil, err
 	}
 
-	if err := c.doRequest(ctx, query, variables, &response); err != nil {
+	return &response, nil
+}
+
+// UpdateMemoryInput represents the input for updating a memory.
+type UpdateMemoryInput struct {
+	Description *string `json:"description,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Text *string `json:"text,omitempty"`
+}
+
+// UpdateMemoryResponse represents the response from updating a memory.
+type UpdateMemoryResponse struct {
+	ID *string `json:"id"`
+}
+
+// UpdateMemory updates an existing memory.
+func (c *Client) UpdateMemory(ctx context.Context, id string, input UpdateMemoryInput) (*UpdateMemoryResponse, error) {
+	var response UpdateMemoryResponse
+	if err := c.doRESTRequest(ctx, "POST", "/memory/"+url.PathEscape(id)+"/update", input, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// DeleteMemoryResponse represents the response from deleting a memory.
+type DeleteMemoryResponse struct {
+	ID *string `json:"id"`
+}
+
+// DeleteMemory deletes a memory.
+func (c *Client) DeleteMemory(ctx context.Context, id string) (*DeleteMemoryResponse, error) {
+	var response DeleteMemoryResponse
+	if err := c.doRESTRequest(ctx, "POST", "/memory/"+url.PathEscape(id)+"/delete", map[string]interface{}{}, &response); err != nil {
 		return nil, err
 	}
 
-	// Find the resource with matching ID
-	for _, edge := range response.McpserverIntegrations.Edges {
-		if edge.Node != nil && edge.Node.ID != nil && *edge.Node.ID == id {
-			return edge.Node, nil
+	return &response, nil
+}
+
+// GetMemoryResponse represents the response from fetching a memory.
+type GetMemoryResponse struct {
+	ID *string `json:"id"`
+	Description *string `json:"description,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Text *string `json:"text,omitempty"`
+	CreatedAt *string `json:"createdAt,omitempty"`
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+}
+
+// itemID returns the list cursor of a memory.
+func (r *GetMemoryResponse) itemID() *string {
+	return r.ID
+}
+
+// ListMemories fetches every memory in the account, oldest first.
+func (c *Client) ListMemories(ctx context.Context) ([]*GetMemoryResponse, error) {
+	return listREST[GetMemoryResponse](ctx, c, "/memory/list")
+}
+
+// GetMemory fetches a memory by ID.
+func (c *Client) GetMemory(ctx context.Context, id string) (*GetMemoryResponse, error) {
+	var response GetMemoryResponse
+	if err := c.doRESTRequest(ctx, "GET", "/memory/"+url.PathEscape(id)+"/fetch", nil, &response); err != nil {
+		if strings.Contains(err.Error(), "status 404") {
+			return nil, fmt.Errorf("memory with ID %s not found", id)
 		}
+		return nil, err
 	}
 
-	return nil, fmt.Errorf("mcpserverintegration with ID %s not found", id)
+	return &response, nil
 }
 
 
@@ -1702,11 +2487,28 @@ type GetMessengerIntegrationResponse struct {
 
 // GetMessengerIntegration fetches a messengerintegration by ID.
 func (c *Client) GetMessengerIntegration(ctx context.Context, id string) (*GetMessengerIntegrationResponse, error) {
-	// Note: The GraphQL API uses connection-based queries, so we filter by ID
+	// The GraphQL API has no lookup by ID, so walk the connection
+	items, err := c.ListMessengerIntegrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item != nil && item.ID != nil && *item.ID == id {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("messengerintegration with ID %s not found", id)
+}
+
+// ListMessengerIntegrations lists all messengerIntegrations.
+func (c *Client) ListMessengerIntegrations(ctx context.Context) ([]*GetMessengerIntegrationResponse, error) {
 	query := `
-		query GetMessengerIntegration($cursor: ID) {
-			messengerIntegrations(first: 1, after: $cursor) {
+		query ListMessengerIntegrations($first: Int, $cursor: ID) {
+			messengerIntegrations(first: $first, after: $cursor) {
 				edges {
+					cursor
 					node {
 						id
 						accessToken
@@ -1721,34 +2523,15 @@ func (c *Client) GetMessengerIntegration(ctx context.Context, id string) (*GetMe
 						updatedAt
 					}
 				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
 			}
 		}
 	`
 
-	// For read operations, we need to iterate through results to find by ID
-	// This is a simplified implementation - in production, you'd want proper pagination
-	variables := map[string]interface{}{}
-
-	var response struct {
-		MessengerIntegrations struct {
-			Edges []struct {
-				Node *GetMessengerIntegrationResponse `json:"node"`
-			} `json:"edges"`
-		} `json:"messengerIntegrations"`
-	}
-
-	if err := c.doRequest(ctx, query, variables, &response); err != nil {
-		return nil, err
-	}
-
-	// Find the resource with matching ID
-	for _, edge := range response.MessengerIntegrations.Edges {
-		if edge.Node != nil && edge.Node.ID != nil && *edge.Node.ID == id {
-			return edge.Node, nil
-		}
-	}
-
-	return nil, fmt.Errorf("messengerintegration with ID %s not found", id)
+	return listConnection[GetMessengerIntegrationResponse](ctx, c, query, "messengerIntegrations", nil)
 }
 
 
@@ -1821,119 +2604,484 @@ func (c *Client) UpdateNotionIntegration(ctx context.Context, id string, input U
 		}
 	`
 
-	variables := map[string]interface{}{
-		"notionIntegrationId": id,
-		"input":              input,
-	}
+	variables := map[string]interface{}{
+		"notionIntegrationId": id,
+		"input":              input,
+	}
+
+	var response struct {
+		UpdateNotionIntegration *UpdateNotionIntegrationResponse `json:"updateNotionIntegration"`
+	}
+
+	if err := c.doRequest(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	return response.UpdateNotionIntegration, nil
+}
+
+// DeleteNotionIntegrationResponse represents the response from deleting a notionintegration.
+type DeleteNotionIntegrationResponse struct {
+	ID *string `json:"id"`
+}
+
+// DeleteNotionIntegration deletes a notionintegration.
+func (c *Client) DeleteNotionIntegration(ctx context.Context, id string) (*DeleteNotionIntegrationResponse, error) {
+	query := `
+		mutation DeleteNotionIntegration($notionIntegrationId: ID!) {
+			deleteNotionIntegration(notionIntegrationId: $notionIntegrationId) {
+				id
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"notionIntegrationId": id,
+	}
+
+	var response struct {
+		DeleteNotionIntegration *DeleteNotionIntegrationResponse `json:"deleteNotionIntegration"`
+	}
+
+	if err := c.doRequest(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	return response.DeleteNotionIntegration, nil
+}
+
+// GetNotionIntegrationResponse represents the response from fetching a notionintegration.
+type GetNotionIntegrationResponse struct {
+	ID *string `json:"id"`
+	BlueprintId *string `json:"blueprintId,omitempty"`
+	DatasetId *string `json:"datasetId,omitempty"`
+	Description *string `json:"description,omitempty"`
+	ExpiresIn *int64 `json:"expiresIn,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	Name *string `json:"name,omitempty"`
+	SyncSchedule *string `json:"syncSchedule,omitempty"`
+	Token *string `json:"token,omitempty"`
+	CreatedAt *string `json:"createdAt,omitempty"`
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+}
+
+// GetNotionIntegration fetches a notionintegration by ID.
+func (c *Client) GetNotionIntegration(ctx context.Context, id string) (*GetNotionIntegrationResponse, error) {
+	// The GraphQL API has no lookup by ID, so walk the connection
+	items, err := c.ListNotionIntegrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item != nil && item.ID != nil && *item.ID == id {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("notionintegration with ID %s not found", id)
+}
+
+// ListNotionIntegrations lists all notionIntegrations.
+func (c *Client) ListNotionIntegrations(ctx context.Context) ([]*GetNotionIntegrationResponse, error) {
+	query := `
+		query ListNotionIntegrations($first: Int, $cursor: ID) {
+			notionIntegrations(first: $first, after: $cursor) {
+				edges {
+					cursor
+					node {
+						id
+						blueprintId
+						datasetId
+						description
+						expiresIn
+						meta
+						name
+						syncSchedule
+						token
+						createdAt
+						updatedAt
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	return listConnection[GetNotionIntegrationResponse](ctx, c, query, "notionIntegrations", nil)
+}
+
+
+// ClonePlatformExampleResponse represents the response from cloning a
+// platform example.
+type ClonePlatformExampleResponse struct {
+	// Resources maps resource types to the resources created by the clone
+	Resources map[string]interface{} `json:"resources,omitempty"`
+}
+
+// ClonePlatformExample clones a platform example into the account.
+func (c *Client) ClonePlatformExample(ctx context.Context, id string) (*ClonePlatformExampleResponse, error) {
+	query := `
+		mutation ClonePlatformExample($input: ClonePlatformExampleInput!) {
+			clonePlatformExample(input: $input) {
+				resources
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"id": id,
+		},
+	}
+
+	var response struct {
+		ClonePlatformExample *ClonePlatformExampleResponse `json:"clonePlatformExample"`
+	}
+
+	if err := c.doRequest(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if response.ClonePlatformExample == nil {
+		return nil, fmt.Errorf("clone of platform example %s returned no result", id)
+	}
+
+	return response.ClonePlatformExample, nil
+}
+
+// PlatformModelResponse represents a model available on the platform.
+type PlatformModelResponse struct {
+	ID *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Provider *string `json:"provider,omitempty"`
+	Family *string `json:"family,omitempty"`
+	MaxTokens *int64 `json:"maxTokens,omitempty"`
+	MaxInputTokens *int64 `json:"maxInputTokens,omitempty"`
+	MaxOutputTokens *int64 `json:"maxOutputTokens,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	CreatedAt *string `json:"createdAt,omitempty"`
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+}
+
+// itemID returns the list cursor of a platform model.
+func (r *PlatformModelResponse) itemID() *string {
+	return r.ID
+}
+
+// ListPlatformModels lists all models available on the platform.
+func (c *Client) ListPlatformModels(ctx context.Context) ([]*PlatformModelResponse, error) {
+	return listREST[PlatformModelResponse](ctx, c, "/platform/model/list")
+}
+
+// PlatformReportResponse represents a platform report and its data.
+type PlatformReportResponse struct {
+	ID *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Report map[string]interface{} `json:"report,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	CreatedAt *string `json:"createdAt,omitempty"`
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+}
+
+// FetchPlatformReport generates a platform report for the given parameters.
+func (c *Client) FetchPlatformReport(ctx context.Context, id string, parameters map[string]interface{}) (*PlatformReportResponse, error) {
+	if parameters == nil {
+		parameters = map[string]interface{}{}
+	}
+
+	var result PlatformReportResponse
+	if err := c.doRESTRequest(ctx, "POST", "/platform/report/"+url.PathEscape(id)+"/fetch", parameters, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// PlatformExampleResponse represents an example in the platform catalog.
+type PlatformExampleResponse struct {
+	ID *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Type *string `json:"type,omitempty"`
+	Link *string `json:"link,omitempty"`
+	Tags []string `json:"tags,omitempty"`
+	Config map[string]interface{} `json:"config,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	CreatedAt *string `json:"createdAt,omitempty"`
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+}
+
+// itemID returns the list cursor of a platform example.
+func (r *PlatformExampleResponse) itemID() *string {
+	return r.ID
+}
+
+// ListPlatformExamples lists all examples in the platform catalog. The list
+// does not include the example configuration.
+func (c *Client) ListPlatformExamples(ctx context.Context) ([]*PlatformExampleResponse, error) {
+	return listREST[PlatformExampleResponse](ctx, c, "/platform/example/list")
+}
+
+// FetchPlatformExample fetches an example from the platform catalog including
+// its full configuration.
+func (c *Client) FetchPlatformExample(ctx context.Context, id string) (*PlatformExampleResponse, error) {
+	var result PlatformExampleResponse
+	if err := c.doRESTRequest(ctx, "GET", "/platform/example/"+url.PathEscape(id)+"/fetch", nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// PlatformAbilityResponse represents an ability in the platform catalog.
+type PlatformAbilityResponse struct {
+	ID *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Commentary *string `json:"commentary,omitempty"`
+	Provider *string `json:"provider,omitempty"`
+	Icon *string `json:"icon,omitempty"`
+	Instruction *string `json:"instruction,omitempty"`
+	Schema map[string]interface{} `json:"schema,omitempty"`
+	Secret *string `json:"secret,omitempty"`
+	Setup *string `json:"setup,omitempty"`
+	Space *string `json:"space,omitempty"`
+	File *string `json:"file,omitempty"`
+	Tags []string `json:"tags,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	CreatedAt *string `json:"createdAt,omitempty"`
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+}
+
+// itemID returns the list cursor of a platform ability.
+func (r *PlatformAbilityResponse) itemID() *string {
+	return r.ID
+}
 
-	var response struct {
-		UpdateNotionIntegration *UpdateNotionIntegrationResponse `json:"updateNotionIntegration"`
-	}
+// ListPlatformAbilities lists all abilities in the platform catalog.
+func (c *Client) ListPlatformAbilities(ctx context.Context) ([]*PlatformAbilityResponse, error) {
+	return listREST[PlatformAbilityResponse](ctx, c, "/platform/ability/list")
+}
 
-	if err := c.doRequest(ctx, query, variables, &response); err != nil {
+// GetPlatformAbility fetches an ability from the platform catalog.
+func (c *Client) GetPlatformAbility(ctx context.Context, id string) (*PlatformAbilityResponse, error) {
+	abilities, err := c.ListPlatformAbilities(ctx)
+	if err != nil {
 		return nil, err
 	}
 
-	return response.UpdateNotionIntegration, nil
+	for _, ability := range abilities {
+		if ability != nil && ability.ID != nil && *ability.ID == id {
+			return ability, nil
+		}
+	}
+
+	return nil, fmt.Errorf("platform ability with ID %s not found", id)
 }
 
-// DeleteNotionIntegrationResponse represents the response from deleting a notionintegration.
-type DeleteNotionIntegrationResponse struct {
-	ID *string `json:"id"`
+// PlatformSecretResponse represents a secret template in the platform catalog.
+type PlatformSecretResponse struct {
+	ID *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Commentary *string `json:"commentary,omitempty"`
+	Icon *string `json:"icon,omitempty"`
+	Kind *string `json:"kind,omitempty"`
+	Type *string `json:"type,omitempty"`
+	Config map[string]interface{} `json:"config,omitempty"`
+	Setup *string `json:"setup,omitempty"`
+	Tags []string `json:"tags,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	CreatedAt *string `json:"createdAt,omitempty"`
+	UpdatedAt *string `json:"updatedAt,omitempty"`
 }
 
-// DeleteNotionIntegration deletes a notionintegration.
-func (c *Client) DeleteNotionIntegration(ctx context.Context, id string) (*DeleteNotionIntegrationResponse, error) {
-	query := `
-		mutation DeleteNotionIntegration($notionIntegrationId: ID!) {
-			deleteNotionIntegration(notionIntegrationId: $notionIntegrationId) {
-				id
-			}
-		}
-	`
+// itemID returns the list cursor of a platform secret.
+func (r *PlatformSecretResponse) itemID() *string {
+	return r.ID
+}
 
-	variables := map[string]interface{}{
-		"notionIntegrationId": id,
-	}
+// ListPlatformSecrets lists all secret templates in the platform catalog.
+func (c *Client) ListPlatformSecrets(ctx context.Context) ([]*PlatformSecretResponse, error) {
+	return listREST[PlatformSecretResponse](ctx, c, "/platform/secret/list")
+}
 
-	var response struct {
-		DeleteNotionIntegration *DeleteNotionIntegrationResponse `json:"deleteNotionIntegration"`
+// GetPlatformSecret fetches a secret template from the platform catalog.
+func (c *Client) GetPlatformSecret(ctx context.Context, id string) (*PlatformSecretResponse, error) {
+	secrets, err := c.ListPlatformSecrets(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := c.doRequest(ctx, query, variables, &response); err != nil {
-		return nil, err
+	for _, secret := range secrets {
+		if secret != nil && secret.ID != nil && *secret.ID == id {
+			return secret, nil
+		}
 	}
 
-	return response.DeleteNotionIntegration, nil
+	return nil, fmt.Errorf("platform secret with ID %s not found", id)
 }
 
-// GetNotionIntegrationResponse represents the response from fetching a notionintegration.
-type GetNotionIntegrationResponse struct {
+// AuditLogResponse represents an entry of the account audit log.
+type AuditLogResponse struct {
 	ID *string `json:"id"`
+	Action *string `json:"action,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	IpAddress *string `json:"ipAddress,omitempty"`
+	UserAgent *string `json:"userAgent,omitempty"`
+	AbilityId *string `json:"abilityId,omitempty"`
 	BlueprintId *string `json:"blueprintId,omitempty"`
+	BotId *string `json:"botId,omitempty"`
+	ContactId *string `json:"contactId,omitempty"`
+	ConversationId *string `json:"conversationId,omitempty"`
 	DatasetId *string `json:"datasetId,omitempty"`
-	Description *string `json:"description,omitempty"`
-	ExpiresIn *int64 `json:"expiresIn,omitempty"`
+	FileId *string `json:"fileId,omitempty"`
+	PolicyId *string `json:"policyId,omitempty"`
+	PortalId *string `json:"portalId,omitempty"`
+	RecordId *string `json:"recordId,omitempty"`
+	SecretId *string `json:"secretId,omitempty"`
+	SessionId *string `json:"sessionId,omitempty"`
+	SkillsetId *string `json:"skillsetId,omitempty"`
+	SpaceId *string `json:"spaceId,omitempty"`
+	TaskId *string `json:"taskId,omitempty"`
+	WebhookId *string `json:"webhookId,omitempty"`
+	OldValues map[string]interface{} `json:"oldValues,omitempty"`
+	NewValues map[string]interface{} `json:"newValues,omitempty"`
 	Meta map[string]interface{} `json:"meta,omitempty"`
-	Name *string `json:"name,omitempty"`
-	SyncSchedule *string `json:"syncSchedule,omitempty"`
-	Token *string `json:"token,omitempty"`
 	CreatedAt *string `json:"createdAt,omitempty"`
 	UpdatedAt *string `json:"updatedAt,omitempty"`
 }
 
-// GetNotionIntegration fetches a notionintegration by ID.
-func (c *Client) GetNotionIntegration(ctx context.Context, id string) (*GetNotionIntegrationResponse, error) {
-	// Note: The GraphQL API uses connection-based queries, so we filter by ID
+// ListAuditLogs walks the account audit log and passes every entry to visit
+// until it returns false. The order of the entries is not guaranteed.
+func (c *Client) ListAuditLogs(ctx context.Context, visit func(*AuditLogResponse) bool) error {
 	query := `
-		query GetNotionIntegration($cursor: ID) {
-			notionIntegrations(first: 1, after: $cursor) {
+		query ListAuditLogs($first: Int, $cursor: ID) {
+			auditLogs(first: $first, after: $cursor) {
 				edges {
+					cursor
 					node {
 						id
+						action
+						name
+						description
+						ipAddress
+						userAgent
+						abilityId
 						blueprintId
+						botId
+						contactId
+						conversationId
 						datasetId
-						description
-						expiresIn
+						fileId
+						policyId
+						portalId
+						recordId
+						secretId
+						sessionId
+						skillsetId
+						spaceId
+						taskId
+						webhookId
+						oldValues
+						newValues
 						meta
-						name
-						syncSchedule
-						token
 						createdAt
 						updatedAt
 					}
 				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
 			}
 		}
 	`
 
-	// For read operations, we need to iterate through results to find by ID
-	// This is a simplified implementation - in production, you'd want proper pagination
-	variables := map[string]interface{}{}
-
-	var response struct {
-		NotionIntegrations struct {
-			Edges []struct {
-				Node *GetNotionIntegrationResponse `json:"node"`
-			} `json:"edges"`
-		} `json:"notionIntegrations"`
-	}
+	return walkConnection(ctx, c, query, nil,
+		func(response map[string]*Connection[AuditLogResponse]) (*Connection[AuditLogResponse], error) {
+			return response["auditLogs"], nil
+		},
+		visit,
+	)
+}
 
-	if err := c.doRequest(ctx, query, variables, &response); err != nil {
-		return nil, err
-	}
+// EventLogResponse represents an entry of the account event log.
+type EventLogResponse struct {
+	ID *string `json:"id"`
+	Type *string `json:"type,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	AbilityId *string `json:"abilityId,omitempty"`
+	BlueprintId *string `json:"blueprintId,omitempty"`
+	BotId *string `json:"botId,omitempty"`
+	ContactId *string `json:"contactId,omitempty"`
+	ConversationId *string `json:"conversationId,omitempty"`
+	DatasetId *string `json:"datasetId,omitempty"`
+	FileId *string `json:"fileId,omitempty"`
+	RecordId *string `json:"recordId,omitempty"`
+	SecretId *string `json:"secretId,omitempty"`
+	SkillsetId *string `json:"skillsetId,omitempty"`
+	SpaceId *string `json:"spaceId,omitempty"`
+	TaskId *string `json:"taskId,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	CreatedAt *string `json:"createdAt,omitempty"`
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+}
 
-	// Find the resource with matching ID
-	for _, edge := range response.NotionIntegrations.Edges {
-		if edge.Node != nil && edge.Node.ID != nil && *edge.Node.ID == id {
-			return edge.Node, nil
+// ListEventLogs walks the account event log and passes every entry to visit
+// until it returns false. The order of the entries is not guaranteed.
+func (c *Client) ListEventLogs(ctx context.Context, visit func(*EventLogResponse) bool) error {
+	query := `
+		query ListEventLogs($first: Int, $cursor: ID) {
+			eventLogs(first: $first, after: $cursor) {
+				edges {
+					cursor
+					node {
+						id
+						type
+						name
+						description
+						abilityId
+						blueprintId
+						botId
+						contactId
+						conversationId
+						datasetId
+						fileId
+						recordId
+						secretId
+						skillsetId
+						spaceId
+						taskId
+						meta
+						createdAt
+						updatedAt
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
 		}
-	}
+	`
 
-	return nil, fmt.Errorf("notionintegration with ID %s not found", id)
+	return walkConnection(ctx, c, query, nil,
+		func(response map[string]*Connection[EventLogResponse]) (*Connection[EventLogResponse], error) {
+			return response["eventLogs"], nil
+		},
+		visit,
+	)
 }
 
-
 // CreatePortalInput represents the input for creating a portal.
 type CreatePortalInput struct {
 	BlueprintId *string `json:"blueprintId,omitempty"`
@@ -2060,11 +3208,28 @@ type GetPortalResponse struct {
 
 // GetPortal fetches a portal by ID.
 func (c *Client) GetPortal(ctx context.Context, id string) (*GetPortalResponse, error) {
-	// Note: The GraphQL API uses connection-based queries, so we filter by ID
+	// The GraphQL API has no lookup by ID, so walk the connection
+	items, err := c.ListPortals(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item != nil && item.ID != nil && *item.ID == id {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("portal with ID %s not found", id)
+}
+
+// ListPortals lists all portals.
+func (c *Client) ListPortals(ctx context.Context) ([]*GetPortalResponse, error) {
 	query := `
-		query GetPortal($cursor: ID) {
-			portals(first: 1, after: $cursor) {
+		query ListPortals($first: Int, $cursor: ID) {
+			portals(first: $first, after: $cursor) {
 				edges {
+					cursor
 					node {
 						id
 						blueprintId
@@ -2077,34 +3242,15 @@ func (c *Client) GetPortal(ctx context.Context, id string) (*GetPortalResponse,
 						updatedAt
 					}
 				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
 			}
 		}
 	`
 
-	// For read operations, we need to iterate through results to find by ID
-	// This is a simplified implementation - in production, you'd want proper pagination
-	variables := map[string]interface{}{}
-
-	var response struct {
-		Portals struct {
-			Edges []struct {
-				Node *GetPortalResponse `json:"node"`
-			} `json:"edges"`
-		} `json:"portals"`
-	}
-
-	if err := c.doRequest(ctx, query, variables, &response); err != nil {
-		return nil, err
-	}
-
-	// Find the resource with matching ID
-	for _, edge := range response.Portals.Edges {
-		if edge.Node != nil && edge.Node.ID != nil && *edge.Node.ID == id {
-			return edge.Node, nil
-		}
-	}
-
-	return nil, fmt.Errorf("portal with ID %s not found", id)
+	return listConnection[GetPortalResponse](ctx, c, query, "portals", nil)
 }
 
 
@@ -2225,6 +3371,37 @@ func (c *Client) DeleteSecret(ctx context.Context, id string) (*DeleteSecretResp
 	return response.DeleteSecret, nil
 }
 
+// RevokeSecretResponse represents the response from revoking a secret.
+type RevokeSecretResponse struct {
+	ID *string `json:"id"`
+}
+
+// RevokeSecret revokes the credentials granted to a secret, such as an OAuth
+// grant, while keeping the secret itself.
+func (c *Client) RevokeSecret(ctx context.Context, id string) (*RevokeSecretResponse, error) {
+	query := `
+		mutation RevokeSecret($secretId: ID!) {
+			revokeSecret(secretId: $secretId) {
+				id
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"secretId": id,
+	}
+
+	var response struct {
+		RevokeSecret *RevokeSecretResponse `json:"revokeSecret"`
+	}
+
+	if err := c.doRequest(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	return response.RevokeSecret, nil
+}
+
 // GetSecretResponse represents the response from fetching a secret.
 type GetSecretResponse struct {
 	ID *string `json:"id"`
@@ -2237,17 +3414,60 @@ type GetSecretResponse struct {
 	Type *string `json:"type,omitempty"`
 	Value *string `json:"value,omitempty"`
 	Visibility *string `json:"visibility,omitempty"`
+	Verification *SecretVerificationResponse `json:"verification,omitempty"`
+	Contacts []*SecretContactResponse `json:"contacts,omitempty"`
 	CreatedAt *string `json:"createdAt,omitempty"`
 	UpdatedAt *string `json:"updatedAt,omitempty"`
 }
 
+// SecretContactResponse represents a contact that authenticated a personal
+// secret, with the verification state of the secret for that contact.
+type SecretContactResponse struct {
+	ID *string `json:"id"`
+	Name *string `json:"name,omitempty"`
+	Nick *string `json:"nick,omitempty"`
+	Email *string `json:"email,omitempty"`
+	Phone *string `json:"phone,omitempty"`
+	Verification *SecretVerificationResponse `json:"verification,omitempty"`
+}
+
+// SecretVerificationResponse represents the verification state of a secret.
+type SecretVerificationResponse struct {
+	Status *string `json:"status,omitempty"`
+	Action *SecretVerificationActionResponse `json:"action,omitempty"`
+}
+
+// SecretVerificationActionResponse represents the action required to verify a
+// secret.
+type SecretVerificationActionResponse struct {
+	Type *string `json:"type,omitempty"`
+	URL *string `json:"url,omitempty"`
+}
+
 // GetSecret fetches a secret by ID.
 func (c *Client) GetSecret(ctx context.Context, id string) (*GetSecretResponse, error) {
-	// Note: The GraphQL API uses connection-based queries, so we filter by ID
+	// The GraphQL API has no lookup by ID, so walk the connection
+	items, err := c.ListSecrets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item != nil && item.ID != nil && *item.ID == id {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("secret with ID %s not found", id)
+}
+
+// ListSecrets lists all secrets.
+func (c *Client) ListSecrets(ctx context.Context) ([]*GetSecretResponse, error) {
 	query := `
-		query GetSecret($cursor: ID) {
-			secrets(first: 1, after: $cursor) {
+		query ListSecrets($first: Int, $cursor: ID) {
+			secrets(first: $first, after: $cursor) {
 				edges {
+					cursor
 					node {
 						id
 						blueprintId
@@ -2259,38 +3479,40 @@ func (c *Client) GetSecret(ctx context.Context, id string) (*GetSecretResponse,
 						type
 						value
 						visibility
+						verification {
+							status
+							action {
+								type
+								url
+							}
+						}
+						contacts {
+							id
+							name
+							nick
+							email
+							phone
+							verification {
+								status
+								action {
+									type
+									url
+								}
+							}
+						}
 						createdAt
 						updatedAt
 					}
 				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
 			}
 		}
 	`
 
-	// For read operations, we need to iterate through results to find by ID
-	// This is a simplified implementation - in production, you'd want proper pagination
-	variables := map[string]interface{}{}
-
-	var response struct {
-		Secrets struct {
-			Edges []struct {
-				Node *GetSecretResponse `json:"node"`
-			} `json:"edges"`
-		} `json:"secrets"`
-	}
-
-	if err := c.doRequest(ctx, query, variables, &response); err != nil {
-		return nil, err
-	}
-
-	// Find the resource with matching ID
-	for _, edge := range response.Secrets.Edges {
-		if edge.Node != nil && edge.Node.ID != nil && *edge.Node.ID == id {
-			return edge.Node, nil
-		}
-	}
-
-	return nil, fmt.Errorf("secret with ID %s not found", id)
+	return listConnection[GetSecretResponse](ctx, c, query, "secrets", nil)
 }
 
 
@@ -2435,11 +3657,28 @@ type GetSitemapIntegrationResponse struct {
 
 // GetSitemapIntegration fetches a sitemapintegration by ID.
 func (c *Client) GetSitemapIntegration(ctx context.Context, id string) (*GetSitemapIntegrationResponse, error) {
-	// Note: The GraphQL API uses connection-based queries, so we filter by ID
+	// The GraphQL API has no lookup by ID, so walk the connection
+	items, err := c.ListSitemapIntegrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item != nil && item.ID != nil && *item.ID == id {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("sitemapintegration with ID %s not found", id)
+}
+
+// ListSitemapIntegrations lists all sitemapIntegrations.
+func (c *Client) ListSitemapIntegrations(ctx context.Context) ([]*GetSitemapIntegrationResponse, error) {
 	query := `
-		query GetSitemapIntegration($cursor: ID) {
-			sitemapIntegrations(first: 1, after: $cursor) {
+		query ListSitemapIntegrations($first: Int, $cursor: ID) {
+			sitemapIntegrations(first: $first, after: $cursor) {
 				edges {
+					cursor
 					node {
 						id
 						blueprintId
@@ -2457,34 +3696,15 @@ func (c *Client) GetSitemapIntegration(ctx context.Context, id string) (*GetSite
 						updatedAt
 					}
 				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
 			}
 		}
 	`
 
-	// For read operations, we need to iterate through results to find by ID
-	// This is a simplified implementation - in production, you'd want proper pagination
-	variables := map[string]interface{}{}
-
-	var response struct {
-		SitemapIntegrations struct {
-			Edges []struct {
-				Node *GetSitemapIntegrationResponse `json:"node"`
-			} `json:"edges"`
-		} `json:"sitemapIntegrations"`
-	}
-
-	if err := c.doRequest(ctx, query, variables, &response); err != nil {
-		return nil, err
-	}
-
-	// Find the resource with matching ID
-	for _, edge := range response.SitemapIntegrations.Edges {
-		if edge.Node != nil && edge.Node.ID != nil && *edge.Node.ID == id {
-			return edge.Node, nil
-		}
-	}
-
-	return nil, fmt.Errorf("sitemapintegration with ID %s not found", id)
+	return listConnection[GetSitemapIntegrationResponse](ctx, c, query, "sitemapIntegrations", nil)
 }
 
 
@@ -2624,17 +3844,18 @@ type GetSkillsetAbilityResponse struct {
 	UpdatedAt *string `json:"updatedAt,omitempty"`
 }
 
-// GetSkillsetAbility fetches a skillsetability by ID.
-func (c *Client) GetSkillsetAbility(ctx context.Context, skillsetId string, id string) (*GetSkillsetAbilityResponse, error) {
+// ListSkillsetAbilities lists all abilities of a skillset.
+func (c *Client) ListSkillsetAbilities(ctx context.Context, skillsetId string) ([]*GetSkillsetAbilityResponse, error) {
 	// Query abilities through the skillset connection
 	query := `
-		query GetSkillsetAbility($skillsetIds: [ID!]) {
+		query ListSkillsetAbilities($skillsetIds: [ID!], $first: Int, $cursor: ID) {
 			skillsets(first: 1, skillsetIds: $skillsetIds) {
 				edges {
 					node {
 						id
-						abilities(first: 100) {
+						abilities(first: $first, after: $cursor) {
 							edges {
+								cursor
 								node {
 									id
 									blueprintId
@@ -2650,6 +3871,10 @@ func (c *Client) GetSkillsetAbility(ctx context.Context, skillsetId string, id s
 									updatedAt
 								}
 							}
+							pageInfo {
+								hasNextPage
+								endCursor
+							}
 						}
 					}
 				}
@@ -2661,31 +3886,49 @@ func (c *Client) GetSkillsetAbility(ctx context.Context, skillsetId string, id s
 		"skillsetIds": []string{skillsetId},
 	}
 
-	var response struct {
+	type response struct {
 		Skillsets struct {
 			Edges []struct {
 				Node struct {
-					ID        string `json:"id"`
-					Abilities struct {
-						Edges []struct {
-							Node *GetSkillsetAbilityResponse `json:"node"`
-						} `json:"edges"`
-					} `json:"abilities"`
+					ID        string                                  `json:"id"`
+					Abilities *Connection[GetSkillsetAbilityResponse] `json:"abilities"`
 				} `json:"node"`
 			} `json:"edges"`
 		} `json:"skillsets"`
 	}
 
-	if err := c.doRequest(ctx, query, variables, &response); err != nil {
+	var abilities []*GetSkillsetAbilityResponse
+
+	err := walkConnection(ctx, c, query, variables,
+		func(r response) (*Connection[GetSkillsetAbilityResponse], error) {
+			if len(r.Skillsets.Edges) == 0 {
+				return nil, fmt.Errorf("skillset with ID %s not found", skillsetId)
+			}
+			return r.Skillsets.Edges[0].Node.Abilities, nil
+		},
+		func(ability *GetSkillsetAbilityResponse) bool {
+			abilities = append(abilities, ability)
+			return true
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return abilities, nil
+}
+
+// GetSkillsetAbility fetches a skillsetability by ID.
+func (c *Client) GetSkillsetAbility(ctx context.Context, skillsetId string, id string) (*GetSkillsetAbilityResponse, error) {
+	abilities, err := c.ListSkillsetAbilities(ctx, skillsetId)
+	if err != nil {
 		return nil, err
 	}
 
 	// Find the ability with matching ID
-	for _, parentEdge := range response.Skillsets.Edges {
-		for _, abilityEdge := range parentEdge.Node.Abilities.Edges {
-			if abilityEdge.Node != nil && abilityEdge.Node.ID != nil && *abilityEdge.Node.ID == id {
-				return abilityEdge.Node, nil
-			}
+	for _, ability := range abilities {
+		if ability.ID != nil && *ability.ID == id {
+			return ability, nil
 		}
 	}
 
@@ -2814,13 +4057,30 @@ type GetSkillsetResponse struct {
 	UpdatedAt *string `json:"updatedAt,omitempty"`
 }
 
-// GetSkillset fetches a skillset by ID.
-func (c *Client) GetSkillset(ctx context.Context, id string) (*GetSkillsetResponse, error) {
-	// Note: The GraphQL API uses connection-based queries, so we filter by ID
+// GetSkillset fetches a skillset by ID.
+func (c *Client) GetSkillset(ctx context.Context, id string) (*GetSkillsetResponse, error) {
+	// The GraphQL API has no lookup by ID, so walk the connection
+	items, err := c.ListSkillsets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item != nil && item.ID != nil && *item.ID == id {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("skillset with ID %s not found", id)
+}
+
+// ListSkillsets lists all skillsets.
+func (c *Client) ListSkillsets(ctx context.Context) ([]*GetSkillsetResponse, error) {
 	query := `
-		query GetSkillset($cursor: ID) {
-			skillsets(first: 1, after: $cursor) {
+		query ListSkillsets($first: Int, $cursor: ID) {
+			skillsets(first: $first, after: $cursor) {
 				edges {
+					cursor
 					node {
 						id
 						blueprintId
@@ -2832,34 +4092,15 @@ func (c *Client) GetSkillset(ctx context.Context, id string) (*GetSkillsetRespon
 						updatedAt
 					}
 				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
 			}
 		}
 	`
 
-	// For read operations, we need to iterate through results to find by ID
-	// This is a simplified implementation - in production, you'd want proper pagination
-	variables := map[string]interface{}{}
-
-	var response struct {
-		Skillsets struct {
-			Edges []struct {
-				Node *GetSkillsetResponse `json:"node"`
-			} `json:"edges"`
-		} `json:"skillsets"`
-	}
-
-	if err := c.doRequest(ctx, query, variables, &response); err != nil {
-		return nil, err
-	}
-
-	// Find the resource with matching ID
-	for _, edge := range response.Skillsets.Edges {
-		if edge.Node != nil && edge.Node.ID != nil && *edge.Node.ID == id {
-			return edge.Node, nil
-		}
-	}
-
-	return nil, fmt.Errorf("skillset with ID %s not found", id)
+	return listConnection[GetSkillsetResponse](ctx, c, query, "skillsets", nil)
 }
 
 
@@ -3013,11 +4254,28 @@ type GetSlackIntegrationResponse struct {
 
 // GetSlackIntegration fetches a slackintegration by ID.
 func (c *Client) GetSlackIntegration(ctx context.Context, id string) (*GetSlackIntegrationResponse, error) {
-	// Note: The GraphQL API uses connection-based queries, so we filter by ID
+	// The GraphQL API has no lookup by ID, so walk the connection
+	items, err := c.ListSlackIntegrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item != nil && item.ID != nil && *item.ID == id {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("slackintegration with ID %s not found", id)
+}
+
+// ListSlackIntegrations lists all slackIntegrations.
+func (c *Client) ListSlackIntegrations(ctx context.Context) ([]*GetSlackIntegrationResponse, error) {
 	query := `
-		query GetSlackIntegration($cursor: ID) {
-			slackIntegrations(first: 1, after: $cursor) {
+		query ListSlackIntegrations($first: Int, $cursor: ID) {
+			slackIntegrations(first: $first, after: $cursor) {
 				edges {
+					cursor
 					node {
 						id
 						autoRespond
@@ -3038,34 +4296,239 @@ func (c *Client) GetSlackIntegration(ctx context.Context, id string) (*GetSlackI
 						updatedAt
 					}
 				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
 			}
 		}
 	`
 
-	// For read operations, we need to iterate through results to find by ID
-	// This is a simplified implementation - in production, you'd want proper pagination
-	variables := map[string]interface{}{}
+	return listConnection[GetSlackIntegrationResponse](ctx, c, query, "slackIntegrations", nil)
+}
 
-	var response struct {
-		SlackIntegrations struct {
-			Edges []struct {
-				Node *GetSlackIntegrationResponse `json:"node"`
-			} `json:"edges"`
-		} `json:"slackIntegrations"`
+
+// CreateSpaceInput represents the input for creating a space.
+type CreateSpaceInput struct {
+	BlueprintId *string `json:"blueprintId,omitempty"`
+	ContactId *string `json:"contactId,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// CreateSpaceResponse represents the response from creating a space.
+type CreateSpaceResponse struct {
+	ID *string `json:"id"`
+}
+
+// CreateSpace creates a new space.
+func (c *Client) CreateSpace(ctx context.Context, input CreateSpaceInput) (*CreateSpaceResponse, error) {
+	var response CreateSpaceResponse
+	if err := c.doRESTRequest(ctx, "POST", "/space/create", input, &response); err != nil {
+		return nil, err
 	}
 
-	if err := c.doRequest(ctx, query, variables, &response); err != nil {
+	return &response, nil
+}
+
+// UpdateSpaceInput represents the input for updating a space.
+type UpdateSpaceInput struct {
+	BlueprintId *string `json:"blueprintId,omitempty"`
+	ContactId *string `json:"contactId,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// UpdateSpaceResponse represents the response from updating a space.
+type UpdateSpaceResponse struct {
+	ID *string `json:"id"`
+}
+
+// UpdateSpace updates an existing space.
+func (c *Client) UpdateSpace(ctx context.Context, id string, input UpdateSpaceInput) (*UpdateSpaceResponse, error) {
+	var response UpdateSpaceResponse
+	if err := c.doRESTRequest(ctx, "POST", "/space/"+url.PathEscape(id)+"/update", input, &response); err != nil {
 		return nil, err
 	}
 
-	// Find the resource with matching ID
-	for _, edge := range response.SlackIntegrations.Edges {
-		if edge.Node != nil && edge.Node.ID != nil && *edge.Node.ID == id {
-			return edge.Node, nil
+	return &response, nil
+}
+
+// DeleteSpaceResponse represents the response from deleting a space.
+type DeleteSpaceResponse struct {
+	ID *string `json:"id"`
+}
+
+// DeleteSpace deletes a space.
+func (c *Client) DeleteSpace(ctx context.Context, id string) (*DeleteSpaceResponse, error) {
+	var response DeleteSpaceResponse
+	if err := c.doRESTRequest(ctx, "POST", "/space/"+url.PathEscape(id)+"/delete", map[string]interface{}{}, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetSpaceResponse represents the response from fetching a space.
+type GetSpaceResponse struct {
+	ID *string `json:"id"`
+	BlueprintId *string `json:"blueprintId,omitempty"`
+	ContactId *string `json:"contactId,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	Name *string `json:"name,omitempty"`
+	CreatedAt *string `json:"createdAt,omitempty"`
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+}
+
+// itemID returns the list cursor of a space.
+func (r *GetSpaceResponse) itemID() *string {
+	return r.ID
+}
+
+// ListSpaces fetches every space in the account, oldest first.
+func (c *Client) ListSpaces(ctx context.Context) ([]*GetSpaceResponse, error) {
+	return listREST[GetSpaceResponse](ctx, c, "/space/list")
+}
+
+// GetSpace fetches a space by ID.
+func (c *Client) GetSpace(ctx context.Context, id string) (*GetSpaceResponse, error) {
+	var response GetSpaceResponse
+	if err := c.doRESTRequest(ctx, "GET", "/space/"+url.PathEscape(id)+"/fetch", nil, &response); err != nil {
+		if strings.Contains(err.Error(), "status 404") {
+			return nil, fmt.Errorf("space with ID %s not found", id)
 		}
+		return nil, err
 	}
 
-	return nil, fmt.Errorf("slackintegration with ID %s not found", id)
+	return &response, nil
+}
+
+
+// CreateTaskInput represents the input for creating a task.
+type CreateTaskInput struct {
+	BlueprintId *string `json:"blueprintId,omitempty"`
+	BotId *string `json:"botId,omitempty"`
+	ContactId *string `json:"contactId,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Schedule *string `json:"schedule,omitempty"`
+	SessionDuration *float64 `json:"sessionDuration,omitempty"`
+}
+
+// CreateTaskResponse represents the response from creating a task.
+type CreateTaskResponse struct {
+	ID *string `json:"id"`
+}
+
+// CreateTask creates a new task.
+func (c *Client) CreateTask(ctx context.Context, input CreateTaskInput) (*CreateTaskResponse, error) {
+	var response CreateTaskResponse
+	if err := c.doRESTRequest(ctx, "POST", "/task/create", input, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// UpdateTaskInput represents the input for updating a task.
+type UpdateTaskInput struct {
+	BlueprintId *string `json:"blueprintId,omitempty"`
+	BotId *string `json:"botId,omitempty"`
+	ContactId *string `json:"contactId,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Schedule *string `json:"schedule,omitempty"`
+	SessionDuration *float64 `json:"sessionDuration,omitempty"`
+}
+
+// UpdateTaskResponse represents the response from updating a task.
+type UpdateTaskResponse struct {
+	ID *string `json:"id"`
+}
+
+// UpdateTask updates an existing task.
+func (c *Client) UpdateTask(ctx context.Context, id string, input UpdateTaskInput) (*UpdateTaskResponse, error) {
+	var response UpdateTaskResponse
+	if err := c.doRESTRequest(ctx, "POST", "/task/"+url.PathEscape(id)+"/update", input, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// DeleteTaskResponse represents the response from deleting a task.
+type DeleteTaskResponse struct {
+	ID *string `json:"id"`
+}
+
+// DeleteTask deletes a task.
+func (c *Client) DeleteTask(ctx context.Context, id string) (*DeleteTaskResponse, error) {
+	var response DeleteTaskResponse
+	if err := c.doRESTRequest(ctx, "POST", "/task/"+url.PathEscape(id)+"/delete", map[string]interface{}{}, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// ExecuteTaskResponse represents the response from executing a task.
+type ExecuteTaskResponse struct {
+	ID *string `json:"id"`
+}
+
+// ExecuteTask triggers a single execution of a task.
+func (c *Client) ExecuteTask(ctx context.Context, id string) (*ExecuteTaskResponse, error) {
+	var response ExecuteTaskResponse
+	if err := c.doRESTRequest(ctx, "POST", "/task/"+url.PathEscape(id)+"/trigger", map[string]interface{}{}, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetTaskResponse represents the response from fetching a task.
+type GetTaskResponse struct {
+	ID *string `json:"id"`
+	BlueprintId *string `json:"blueprintId,omitempty"`
+	BotId *string `json:"botId,omitempty"`
+	ContactId *string `json:"contactId,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Outcome *string `json:"outcome,omitempty"`
+	Schedule *string `json:"schedule,omitempty"`
+	SessionDuration *float64 `json:"sessionDuration,omitempty"`
+	Status *string `json:"status,omitempty"`
+	CreatedAt *string `json:"createdAt,omitempty"`
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+}
+
+// itemID returns the list cursor of a task.
+func (r *GetTaskResponse) itemID() *string {
+	return r.ID
+}
+
+// ListTasks fetches every task in the account, oldest first.
+func (c *Client) ListTasks(ctx context.Context) ([]*GetTaskResponse, error) {
+	return listREST[GetTaskResponse](ctx, c, "/task/list")
+}
+
+// GetTask fetches a task by ID.
+func (c *Client) GetTask(ctx context.Context, id string) (*GetTaskResponse, error) {
+	var response GetTaskResponse
+	if err := c.doRESTRequest(ctx, "GET", "/task/"+url.PathEscape(id)+"/fetch", nil, &response); err != nil {
+		if strings.Contains(err.Error(), "status 404") {
+			return nil, fmt.Errorf("task with ID %s not found", id)
+		}
+		return nil, err
+	}
+
+	return &response, nil
 }
 
 
@@ -3204,11 +4667,28 @@ type GetTelegramIntegrationResponse struct {
 
 // GetTelegramIntegration fetches a telegramintegration by ID.
 func (c *Client) GetTelegramIntegration(ctx context.Context, id string) (*GetTelegramIntegrationResponse, error) {
-	// Note: The GraphQL API uses connection-based queries, so we filter by ID
+	// The GraphQL API has no lookup by ID, so walk the connection
+	items, err := c.ListTelegramIntegrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item != nil && item.ID != nil && *item.ID == id {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("telegramintegration with ID %s not found", id)
+}
+
+// ListTelegramIntegrations lists all telegramIntegrations.
+func (c *Client) ListTelegramIntegrations(ctx context.Context) ([]*GetTelegramIntegrationResponse, error) {
 	query := `
-		query GetTelegramIntegration($cursor: ID) {
-			telegramIntegrations(first: 1, after: $cursor) {
+		query ListTelegramIntegrations($first: Int, $cursor: ID) {
+			telegramIntegrations(first: $first, after: $cursor) {
 				edges {
+					cursor
 					node {
 						id
 						attachments
@@ -3224,34 +4704,15 @@ func (c *Client) GetTelegramIntegration(ctx context.Context, id string) (*GetTel
 						updatedAt
 					}
 				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
 			}
 		}
 	`
 
-	// For read operations, we need to iterate through results to find by ID
-	// This is a simplified implementation - in production, you'd want proper pagination
-	variables := map[string]interface{}{}
-
-	var response struct {
-		TelegramIntegrations struct {
-			Edges []struct {
-				Node *GetTelegramIntegrationResponse `json:"node"`
-			} `json:"edges"`
-		} `json:"telegramIntegrations"`
-	}
-
-	if err := c.doRequest(ctx, query, variables, &response); err != nil {
-		return nil, err
-	}
-
-	// Find the resource with matching ID
-	for _, edge := range response.TelegramIntegrations.Edges {
-		if edge.Node != nil && edge.Node.ID != nil && *edge.Node.ID == id {
-			return edge.Node, nil
-		}
-	}
-
-	return nil, fmt.Errorf("telegramintegration with ID %s not found", id)
+	return listConnection[GetTelegramIntegrationResponse](ctx, c, query, "telegramIntegrations", nil)
 }
 
 
@@ -3387,11 +4848,28 @@ type GetTriggerIntegrationResponse struct {
 
 // GetTriggerIntegration fetches a triggerintegration by ID.
 func (c *Client) GetTriggerIntegration(ctx context.Context, id string) (*GetTriggerIntegrationResponse, error) {
-	// Note: The GraphQL API uses connection-based queries, so we filter by ID
+	// The GraphQL API has no lookup by ID, so walk the connection
+	items, err := c.ListTriggerIntegrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item != nil && item.ID != nil && *item.ID == id {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("triggerintegration with ID %s not found", id)
+}
+
+// ListTriggerIntegrations lists all triggerIntegrations.
+func (c *Client) ListTriggerIntegrations(ctx context.Context) ([]*GetTriggerIntegrationResponse, error) {
 	query := `
-		query GetTriggerIntegration($cursor: ID) {
-			triggerIntegrations(first: 1, after: $cursor) {
+		query ListTriggerIntegrations($first: Int, $cursor: ID) {
+			triggerIntegrations(first: $first, after: $cursor) {
 				edges {
+					cursor
 					node {
 						id
 						authenticate
@@ -3406,34 +4884,15 @@ func (c *Client) GetTriggerIntegration(ctx context.Context, id string) (*GetTrig
 						updatedAt
 					}
 				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
 			}
 		}
 	`
 
-	// For read operations, we need to iterate through results to find by ID
-	// This is a simplified implementation - in production, you'd want proper pagination
-	variables := map[string]interface{}{}
-
-	var response struct {
-		TriggerIntegrations struct {
-			Edges []struct {
-				Node *GetTriggerIntegrationResponse `json:"node"`
-			} `json:"edges"`
-		} `json:"triggerIntegrations"`
-	}
-
-	if err := c.doRequest(ctx, query, variables, &response); err != nil {
-		return nil, err
-	}
-
-	// Find the resource with matching ID
-	for _, edge := range response.TriggerIntegrations.Edges {
-		if edge.Node != nil && edge.Node.ID != nil && *edge.Node.ID == id {
-			return edge.Node, nil
-		}
-	}
-
-	return nil, fmt.Errorf("triggerintegration with ID %s not found", id)
+	return listConnection[GetTriggerIntegrationResponse](ctx, c, query, "triggerIntegrations", nil)
 }
 
 
@@ -3566,11 +5025,28 @@ type GetTwilioIntegrationResponse struct {
 
 // GetTwilioIntegration fetches a twiliointegration by ID.
 func (c *Client) GetTwilioIntegration(ctx context.Context, id string) (*GetTwilioIntegrationResponse, error) {
-	// Note: The GraphQL API uses connection-based queries, so we filter by ID
+	// The GraphQL API has no lookup by ID, so walk the connection
+	items, err := c.ListTwilioIntegrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item != nil && item.ID != nil && *item.ID == id {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("twiliointegration with ID %s not found", id)
+}
+
+// ListTwilioIntegrations lists all twilioIntegrations.
+func (c *Client) ListTwilioIntegrations(ctx context.Context) ([]*GetTwilioIntegrationResponse, error) {
 	query := `
-		query GetTwilioIntegration($cursor: ID) {
-			twilioIntegrations(first: 1, after: $cursor) {
+		query ListTwilioIntegrations($first: Int, $cursor: ID) {
+			twilioIntegrations(first: $first, after: $cursor) {
 				edges {
+					cursor
 					node {
 						id
 						blueprintId
@@ -3584,34 +5060,15 @@ func (c *Client) GetTwilioIntegration(ctx context.Context, id string) (*GetTwili
 						updatedAt
 					}
 				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
 			}
 		}
 	`
 
-	// For read operations, we need to iterate through results to find by ID
-	// This is a simplified implementation - in production, you'd want proper pagination
-	variables := map[string]interface{}{}
-
-	var response struct {
-		TwilioIntegrations struct {
-			Edges []struct {
-				Node *GetTwilioIntegrationResponse `json:"node"`
-			} `json:"edges"`
-		} `json:"twilioIntegrations"`
-	}
-
-	if err := c.doRequest(ctx, query, variables, &response); err != nil {
-		return nil, err
-	}
-
-	// Find the resource with matching ID
-	for _, edge := range response.TwilioIntegrations.Edges {
-		if edge.Node != nil && edge.Node.ID != nil && *edge.Node.ID == id {
-			return edge.Node, nil
-		}
-	}
-
-	return nil, fmt.Errorf("twiliointegration with ID %s not found", id)
+	return listConnection[GetTwilioIntegrationResponse](ctx, c, query, "twilioIntegrations", nil)
 }
 
 
@@ -3753,11 +5210,28 @@ type GetWhatsAppIntegrationResponse struct {
 
 // GetWhatsAppIntegration fetches a whatsappintegration by ID.
 func (c *Client) GetWhatsAppIntegration(ctx context.Context, id string) (*GetWhatsAppIntegrationResponse, error) {
-	// Note: The GraphQL API uses connection-based queries, so we filter by ID
+	// The GraphQL API has no lookup by ID, so walk the connection
+	items, err := c.ListWhatsAppIntegrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item != nil && item.ID != nil && *item.ID == id {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("whatsappintegration with ID %s not found", id)
+}
+
+// ListWhatsAppIntegrations lists all whatsAppIntegrations.
+func (c *Client) ListWhatsAppIntegrations(ctx context.Context) ([]*GetWhatsAppIntegrationResponse, error) {
 	query := `
-		query GetWhatsAppIntegration($cursor: ID) {
-			whatsAppIntegrations(first: 1, after: $cursor) {
+		query ListWhatsAppIntegrations($first: Int, $cursor: ID) {
+			whatsAppIntegrations(first: $first, after: $cursor) {
 				edges {
+					cursor
 					node {
 						id
 						accessToken
@@ -3774,32 +5248,13 @@ func (c *Client) GetWhatsAppIntegration(ctx context.Context, id string) (*GetWha
 						updatedAt
 					}
 				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
 			}
 		}
 	`
 
-	// For read operations, we need to iterate through results to find by ID
-	// This is a simplified implementation - in production, you'd want proper pagination
-	variables := map[string]interface{}{}
-
-	var response struct {
-		WhatsAppIntegrations struct {
-			Edges []struct {
-				Node *GetWhatsAppIntegrationResponse `json:"node"`
-			} `json:"edges"`
-		} `json:"whatsAppIntegrations"`
-	}
-
-	if err := c.doRequest(ctx, query, variables, &response); err != nil {
-		return nil, err
-	}
-
-	// Find the resource with matching ID
-	for _, edge := range response.WhatsAppIntegrations.Edges {
-		if edge.Node != nil && edge.Node.ID != nil && *edge.Node.ID == id {
-			return edge.Node, nil
-		}
-	}
-
-	return nil, fmt.Errorf("whatsappintegration with ID %s not found", id)
+	return listConnection[GetWhatsAppIntegrationResponse](ctx, c, query, "whatsAppIntegrations", nil)
 }
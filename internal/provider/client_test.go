@@ -1,10 +1,15 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 )
 
@@ -13,6 +18,11 @@ func ptr(s string) *string {
 	return &s
 }
 
+// Helper function to create a pointer to an int64
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
 func TestNewClient(t *testing.T) {
 	t.Run("creates client with provided values", func(t *testing.T) {
 		client := NewClient("test-api-key", "https://custom.api.com/graphql")
@@ -370,3 +380,663 @@ func TestDoRequest_HTTPError(t *testing.T) {
 		}
 	})
 }
+
+// restCall is a REST request received by a test server.
+type restCall struct {
+	method string
+	path   string
+	query  url.Values
+	body   map[string]interface{}
+}
+
+// newRESTServer starts a test server that records every request and answers
+// with the status and JSON body returned by respond.
+func newRESTServer(t *testing.T, respond func(r *http.Request) (int, interface{})) (*httptest.Server, *[]restCall) {
+	t.Helper()
+
+	calls := &[]restCall{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := restCall{method: r.Method, path: r.URL.Path, query: r.URL.Query()}
+		if r.Body != nil {
+			_ = json.NewDecoder(r.Body).Decode(&call.body)
+		}
+		*calls = append(*calls, call)
+
+		status, body := respond(r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, calls
+}
+
+func TestListContacts(t *testing.T) {
+	t.Run("pages through every contact", func(t *testing.T) {
+		server, calls := newRESTServer(t, func(r *http.Request) (int, interface{}) {
+			if r.URL.Query().Get("cursor") == "contact_100" {
+				return http.StatusOK, map[string]interface{}{
+					"items": []map[string]interface{}{{"id": "contact_101", "phone": "+15550100"}},
+				}
+			}
+			items := make([]map[string]interface{}, connectionPageSize)
+			for i := range items {
+				items[i] = map[string]interface{}{"id": fmt.Sprintf("contact_%d", i+1)}
+			}
+			return http.StatusOK, map[string]interface{}{"items": items}
+		})
+
+		client := NewClient("test-api-key", server.URL)
+		contacts, err := client.ListContacts(context.Background())
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(*calls) != 2 || (*calls)[0].path != "/v1/contact/list" {
+			t.Fatalf("expected 2 requests to '/v1/contact/list', got %v", *calls)
+		}
+		if (*calls)[0].query.Get("order") != "asc" {
+			t.Errorf("expected ascending order, got '%s'", (*calls)[0].query.Get("order"))
+		}
+		if len(contacts) != 101 {
+			t.Fatalf("expected 101 contacts, got %d", len(contacts))
+		}
+		if contacts[100].Phone == nil || *contacts[100].Phone != "+15550100" {
+			t.Errorf("expected phone '+15550100', got '%v'", contacts[100].Phone)
+		}
+	})
+
+	t.Run("stops when the cursor repeats", func(t *testing.T) {
+		server, calls := newRESTServer(t, func(r *http.Request) (int, interface{}) {
+			items := make([]map[string]interface{}, connectionPageSize)
+			for i := range items {
+				items[i] = map[string]interface{}{"id": "contact_1"}
+			}
+			return http.StatusOK, map[string]interface{}{"items": items}
+		})
+
+		client := NewClient("test-api-key", server.URL)
+		_, err := client.ListContacts(context.Background())
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(*calls) != 2 {
+			t.Errorf("expected 2 requests, got %d", len(*calls))
+		}
+	})
+}
+
+func TestContactRequests(t *testing.T) {
+	server, calls := newRESTServer(t, func(r *http.Request) (int, interface{}) {
+		if r.URL.Path == "/v1/contact/contact_missing/fetch" {
+			return http.StatusNotFound, map[string]interface{}{"message": "Not Found"}
+		}
+		return http.StatusOK, map[string]interface{}{"id": "contact_123", "email": "vip@example.com"}
+	})
+
+	client := NewClient("test-api-key", server.URL)
+	ctx := context.Background()
+
+	created, err := client.CreateContact(ctx, CreateContactInput{Email: ptr("vip@example.com")})
+	if err != nil || created.ID == nil || *created.ID != "contact_123" {
+		t.Fatalf("expected created contact 'contact_123', got %v (%v)", created, err)
+	}
+	if _, err := client.UpdateContact(ctx, "contact_123", UpdateContactInput{Name: ptr("VIP")}); err != nil {
+		t.Fatalf("expected no update error, got %v", err)
+	}
+	fetched, err := client.GetContact(ctx, "contact_123")
+	if err != nil || fetched.Email == nil || *fetched.Email != "vip@example.com" {
+		t.Fatalf("expected fetched contact with email, got %v (%v)", fetched, err)
+	}
+	if _, err := client.DeleteContact(ctx, "contact_123"); err != nil {
+		t.Fatalf("expected no delete error, got %v", err)
+	}
+	if _, err := client.GetContact(ctx, "contact_missing"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("expected not found error, got %v", err)
+	}
+
+	expected := []struct{ method, path, field string }{
+		{"POST", "/v1/contact/create", "email"},
+		{"POST", "/v1/contact/contact_123/update", "name"},
+		{"GET", "/v1/contact/contact_123/fetch", ""},
+		{"POST", "/v1/contact/contact_123/delete", ""},
+		{"GET", "/v1/contact/contact_missing/fetch", ""},
+	}
+	assertRESTCalls(t, *calls, expected)
+}
+
+func TestMemoryRequests(t *testing.T) {
+	server, calls := newRESTServer(t, func(r *http.Request) (int, interface{}) {
+		if r.URL.Path == "/v1/memory/memory_missing/fetch" {
+			return http.StatusNotFound, map[string]interface{}{"message": "Not Found"}
+		}
+		return http.StatusOK, map[string]interface{}{"id": "memory_123", "text": "Prefers email"}
+	})
+
+	client := NewClient("test-api-key", server.URL)
+	ctx := context.Background()
+
+	created, err := client.CreateMemory(ctx, CreateMemoryInput{ContactId: ptr("contact_123"), Text: ptr("Prefers email")})
+	if err != nil || created.ID == nil || *created.ID != "memory_123" {
+		t.Fatalf("expected created memory 'memory_123', got %v (%v)", created, err)
+	}
+	if _, err := client.UpdateMemory(ctx, "memory_123", UpdateMemoryInput{Text: ptr("Prefers phone")}); err != nil {
+		t.Fatalf("expected no update error, got %v", err)
+	}
+	fetched, err := client.GetMemory(ctx, "memory_123")
+	if err != nil || fetched.Text == nil || *fetched.Text != "Prefers email" {
+		t.Fatalf("expected fetched memory with text, got %v (%v)", fetched, err)
+	}
+	if _, err := client.DeleteMemory(ctx, "memory_123"); err != nil {
+		t.Fatalf("expected no delete error, got %v", err)
+	}
+	if _, err := client.GetMemory(ctx, "memory_missing"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("expected not found error, got %v", err)
+	}
+
+	expected := []struct{ method, path, field string }{
+		{"POST", "/v1/memory/create", "contactId"},
+		{"POST", "/v1/memory/memory_123/update", "text"},
+		{"GET", "/v1/memory/memory_123/fetch", ""},
+		{"POST", "/v1/memory/memory_123/delete", ""},
+		{"GET", "/v1/memory/memory_missing/fetch", ""},
+	}
+	assertRESTCalls(t, *calls, expected)
+}
+
+// assertRESTCalls checks the method and path of every recorded call and that
+// the request body carries the given field, when one is named.
+func assertRESTCalls(t *testing.T, calls []restCall, expected []struct{ method, path, field string }) {
+	t.Helper()
+
+	if len(calls) != len(expected) {
+		t.Fatalf("expected %d requests, got %d: %v", len(expected), len(calls), calls)
+	}
+	for i, want := range expected {
+		if calls[i].method != want.method || calls[i].path != want.path {
+			t.Errorf("request %d: expected %s '%s', got %s '%s'", i, want.method, want.path, calls[i].method, calls[i].path)
+		}
+		if want.field != "" {
+			if _, ok := calls[i].body[want.field]; !ok {
+				t.Errorf("request %d: expected body field '%s', got %v", i, want.field, calls[i].body)
+			}
+		}
+	}
+}
+
+func TestUploadFile(t *testing.T) {
+	t.Run("streams content as multipart form", func(t *testing.T) {
+		content := bytes.Repeat([]byte("chatbotkit"), 1<<20)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v1/file/file_123/upload" {
+				t.Errorf("expected path '/v1/file/file_123/upload', got '%s'", r.URL.Path)
+			}
+			if r.Header.Get("Authorization") != "Bearer test-api-key" {
+				t.Errorf("expected Authorization header 'Bearer test-api-key', got '%s'", r.Header.Get("Authorization"))
+			}
+
+			reader, err := r.MultipartReader()
+			if err != nil {
+				t.Fatalf("expected multipart request, got %v", err)
+			}
+			part, err := reader.NextPart()
+			if err != nil {
+				t.Fatalf("failed to read part: %v", err)
+			}
+			if part.FormName() != "file" || part.FileName() != "notes.txt" {
+				t.Errorf("expected file part 'notes.txt', got '%s' '%s'", part.FormName(), part.FileName())
+			}
+			if part.Header.Get("Content-Type") != "text/plain" {
+				t.Errorf("expected Content-Type 'text/plain', got '%s'", part.Header.Get("Content-Type"))
+			}
+			received, _ := io.ReadAll(part)
+			if !bytes.Equal(received, content) {
+				t.Errorf("expected %d bytes, got %d", len(content), len(received))
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": "file_123"})
+		}))
+		defer server.Close()
+
+		client := NewClient("test-api-key", server.URL+"/graphql")
+		result, err := client.UploadFile(context.Background(), "file_123", UploadFileInput{
+			Name:        "notes.txt",
+			ContentType: "text/plain",
+			Body:        bytes.NewReader(content),
+		})
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.ID == nil || *result.ID != "file_123" {
+			t.Errorf("expected ID 'file_123', got '%v'", result.ID)
+		}
+	})
+
+	t.Run("returns error on failed upload", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "file too large", http.StatusRequestEntityTooLarge)
+		}))
+		defer server.Close()
+
+		client := NewClient("test-api-key", server.URL)
+		_, err := client.UploadFile(context.Background(), "file_123", UploadFileInput{
+			Name:        "notes.txt",
+			ContentType: "text/plain",
+			Body:        strings.NewReader("hello"),
+		})
+
+		if err == nil || !strings.Contains(err.Error(), "file too large") {
+			t.Errorf("expected upload error, got %v", err)
+		}
+	})
+}
+
+func TestAttachDatasetFile(t *testing.T) {
+	t.Run("attaches file with source type", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v1/dataset/dataset_123/file/file_456/attach" {
+				t.Errorf("expected path '/v1/dataset/dataset_123/file/file_456/attach', got '%s'", r.URL.Path)
+			}
+
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			if body["type"] != "source" {
+				t.Errorf("expected type 'source', got '%v'", body["type"])
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": "dataset_123"})
+		}))
+		defer server.Close()
+
+		client := NewClient("test-api-key", server.URL)
+		_, err := client.AttachDatasetFile(context.Background(), "dataset_123", "file_456", AttachDatasetFileInput{
+			Type: ptr("source"),
+		})
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+}
+
+func TestGetDatasetFile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/dataset/dataset_123/file/list" {
+			t.Errorf("expected path '/v1/dataset/dataset_123/file/list', got '%s'", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"items": []map[string]interface{}{
+				{"id": "file_456", "syncStatus": "synced"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", server.URL)
+
+	t.Run("finds attached file", func(t *testing.T) {
+		result, err := client.GetDatasetFile(context.Background(), "dataset_123", "file_456")
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.SyncStatus == nil || *result.SyncStatus != "synced" {
+			t.Errorf("expected sync status 'synced', got '%v'", result.SyncStatus)
+		}
+	})
+
+	t.Run("returns not found for detached file", func(t *testing.T) {
+		_, err := client.GetDatasetFile(context.Background(), "dataset_123", "file_789")
+
+		if err == nil || !strings.Contains(err.Error(), "not found") {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+}
+
+func TestClonePlatformExample(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+		}
+		input, _ := req.Variables["input"].(map[string]interface{})
+		if input["id"] != "example_123" {
+			t.Errorf("expected input id 'example_123', got '%v'", input["id"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"clonePlatformExample": map[string]interface{}{
+					"resources": map[string]interface{}{
+						"bots":      []interface{}{map[string]interface{}{"id": "bot_1"}},
+						"skillset":  []interface{}{"skillset_1", "skillset_2"},
+						"unknownly": []interface{}{map[string]interface{}{"name": "no id"}},
+					},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", server.URL)
+	result, err := client.ClonePlatformExample(context.Background(), "example_123")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	created := clonedResourceIds(result.Resources)
+
+	if len(created["bot"]) != 1 || created["bot"][0] != "bot_1" {
+		t.Errorf("expected bot IDs [bot_1], got %v", created["bot"])
+	}
+	if len(created["skillset"]) != 2 {
+		t.Errorf("expected 2 skillset IDs, got %v", created["skillset"])
+	}
+	if len(created["unknownly"]) != 0 {
+		t.Errorf("expected entries without an id to be skipped, got %v", created["unknownly"])
+	}
+}
+
+func TestRevokeSecret(t *testing.T) {
+	t.Run("revokes secret successfully", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req GraphQLRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			if !strings.Contains(req.Query, "revokeSecret") {
+				t.Errorf("expected revokeSecret mutation, got %s", req.Query)
+			}
+			if req.Variables["secretId"] != "secret_123" {
+				t.Errorf("expected secretId 'secret_123', got '%v'", req.Variables["secretId"])
+			}
+
+			response := map[string]interface{}{
+				"data": map[string]interface{}{
+					"revokeSecret": map[string]interface{}{
+						"id": "secret_123",
+					},
+				},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(response)
+		}))
+		defer server.Close()
+
+		client := NewClient("test-api-key", server.URL)
+		result, err := client.RevokeSecret(context.Background(), "secret_123")
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.ID == nil || *result.ID != "secret_123" {
+			t.Errorf("expected ID 'secret_123', got '%v'", result.ID)
+		}
+	})
+
+	t.Run("returns GraphQL error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			response := map[string]interface{}{
+				"errors": []map[string]interface{}{
+					{"message": "secret is not revocable"},
+				},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(response)
+		}))
+		defer server.Close()
+
+		client := NewClient("test-api-key", server.URL)
+		_, err := client.RevokeSecret(context.Background(), "secret_123")
+
+		if err == nil || !strings.Contains(err.Error(), "secret is not revocable") {
+			t.Errorf("expected GraphQL error, got %v", err)
+		}
+	})
+}
+
+func TestListPlatformModels(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/platform/model/list" {
+			t.Errorf("expected path '/v1/platform/model/list', got '%s'", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"items": []map[string]interface{}{
+				{"id": "claude-4.5-sonnet", "name": "claude-4.5-sonnet", "provider": "anthropic", "maxInputTokens": 200000},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", server.URL)
+
+	result, err := client.ListPlatformModels(context.Background())
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(result) != 1 || result[0].MaxInputTokens == nil || *result[0].MaxInputTokens != 200000 {
+		t.Errorf("expected one model with 200000 input tokens, got %v", result)
+	}
+}
+
+func TestListPlatformAbilities(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/platform/ability/list" {
+			t.Errorf("expected path '/v1/platform/ability/list', got '%s'", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"items": []map[string]interface{}{
+				{
+					"id":          "google/calendar/list",
+					"instruction": "template: google/calendar/list",
+					"secret":      "google/calendar",
+					"schema":      map[string]interface{}{"type": "object"},
+					"tags":        []string{"google"},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", server.URL)
+
+	result, err := client.ListPlatformAbilities(context.Background())
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(result) != 1 || result[0].Secret == nil || *result[0].Secret != "google/calendar" {
+		t.Fatalf("expected one ability requiring 'google/calendar', got %v", result)
+	}
+	if result[0].Schema["type"] != "object" {
+		t.Errorf("expected schema type 'object', got '%v'", result[0].Schema["type"])
+	}
+}
+
+func TestFetchPlatformExample(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/platform/example/support-agent/fetch" {
+			t.Errorf("expected path '/v1/platform/example/support-agent/fetch', got '%s'", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":     "support-agent",
+			"config": map[string]interface{}{"bot": map[string]interface{}{"model": "gpt-4o"}},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", server.URL)
+
+	result, err := client.FetchPlatformExample(context.Background(), "support-agent")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, ok := result.Config["bot"]; !ok {
+		t.Errorf("expected config to contain 'bot', got %v", result.Config)
+	}
+}
+
+func TestFetchPlatformReport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/v1/platform/report/usage/fetch" {
+			t.Errorf("expected POST '/v1/platform/report/usage/fetch', got %s '%s'", r.Method, r.URL.Path)
+		}
+
+		var parameters map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&parameters)
+		if parameters["botId"] != "bot_123" {
+			t.Errorf("expected botId parameter 'bot_123', got '%v'", parameters["botId"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":     "usage",
+			"report": map[string]interface{}{"tokens": 1200},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", server.URL)
+
+	result, err := client.FetchPlatformReport(context.Background(), "usage", map[string]interface{}{"botId": "bot_123"})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Report["tokens"] != float64(1200) {
+		t.Errorf("expected 1200 tokens, got %v", result.Report["tokens"])
+	}
+}
+
+func TestListSkillsetAbilities(t *testing.T) {
+	t.Run("pages through every ability of the skillset", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req GraphQLRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			requests++
+
+			abilities := map[string]interface{}{
+				"edges": []map[string]interface{}{
+					{"cursor": "a1", "node": map[string]interface{}{"id": "ability_1", "name": "Search"}},
+				},
+				"pageInfo": map[string]interface{}{"hasNextPage": true, "endCursor": "a1"},
+			}
+			if req.Variables["cursor"] == "a1" {
+				abilities = map[string]interface{}{
+					"edges": []map[string]interface{}{
+						{"cursor": "a2", "node": map[string]interface{}{"id": "ability_2", "name": "Fetch", "secretId": "secret_1"}},
+					},
+					"pageInfo": map[string]interface{}{"hasNextPage": false, "endCursor": "a2"},
+				}
+			}
+
+			response := map[string]interface{}{
+				"data": map[string]interface{}{
+					"skillsets": map[string]interface{}{
+						"edges": []map[string]interface{}{
+							{"node": map[string]interface{}{"id": "skillset_1", "abilities": abilities}},
+						},
+					},
+				},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(response)
+		}))
+		defer server.Close()
+
+		client := NewClient("test-api-key", server.URL)
+		abilities, err := client.ListSkillsetAbilities(context.Background(), "skillset_1")
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if requests != 2 {
+			t.Errorf("expected 2 requests, got %d", requests)
+		}
+		if len(abilities) != 2 {
+			t.Fatalf("expected 2 abilities, got %d", len(abilities))
+		}
+		if abilities[1].SecretId == nil || *abilities[1].SecretId != "secret_1" {
+			t.Errorf("expected secret ID 'secret_1', got '%v'", abilities[1].SecretId)
+		}
+	})
+
+	t.Run("returns an error for an unknown skillset", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": map[string]interface{}{
+					"skillsets": map[string]interface{}{"edges": []map[string]interface{}{}},
+				},
+			})
+		}))
+		defer server.Close()
+
+		client := NewClient("test-api-key", server.URL)
+		_, err := client.ListSkillsetAbilities(context.Background(), "skillset_missing")
+
+		if err == nil || !strings.Contains(err.Error(), "not found") {
+			t.Errorf("expected a not found error, got %v", err)
+		}
+	})
+}
+
+func TestSearchDataset(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/v1/dataset/dataset_123/search" {
+			t.Errorf("expected POST '/v1/dataset/dataset_123/search', got %s '%s'", r.Method, r.URL.Path)
+		}
+
+		var input map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&input)
+		if input["search"] != "refund policy" {
+			t.Errorf("expected search 'refund policy', got '%v'", input["search"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"records": []map[string]interface{}{
+				{"id": "record_1", "text": "Refunds are issued within 14 days.", "score": 0.87},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", server.URL)
+
+	records, err := client.SearchDataset(context.Background(), "dataset_123", SearchDatasetInput{Search: ptr("refund policy")})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(records))
+	}
+	if records[0].Score == nil || *records[0].Score != 0.87 {
+		t.Errorf("expected score 0.87, got %v", records[0].Score)
+	}
+}
@@ -0,0 +1,431 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AuditLogsDataSource{}
+
+func NewAuditLogsDataSource() datasource.DataSource {
+	return &AuditLogsDataSource{}
+}
+
+// AuditLogsDataSource defines the data source implementation.
+type AuditLogsDataSource struct {
+	client *Client
+}
+
+// AuditLogsDataSourceModel describes the data source data model.
+type AuditLogsDataSourceModel struct {
+	From types.String `tfsdk:"from"`
+	To types.String `tfsdk:"to"`
+	Action types.String `tfsdk:"action"`
+	BotId types.String `tfsdk:"bot_id"`
+	DatasetId types.String `tfsdk:"dataset_id"`
+	SecretId types.String `tfsdk:"secret_id"`
+	SkillsetId types.String `tfsdk:"skillset_id"`
+	PortalId types.String `tfsdk:"portal_id"`
+	Limit types.Int64 `tfsdk:"limit"`
+	IDs types.List `tfsdk:"ids"`
+	Logs []AuditLogsDataSourceLogModel `tfsdk:"logs"`
+}
+
+// AuditLogsDataSourceLogModel describes an audit log entry returned by the
+// data source.
+type AuditLogsDataSourceLogModel struct {
+	ID types.String `tfsdk:"id"`
+	Action types.String `tfsdk:"action"`
+	Name types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IpAddress types.String `tfsdk:"ip_address"`
+	UserAgent types.String `tfsdk:"user_agent"`
+	AbilityId types.String `tfsdk:"ability_id"`
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	BotId types.String `tfsdk:"bot_id"`
+	ContactId types.String `tfsdk:"contact_id"`
+	ConversationId types.String `tfsdk:"conversation_id"`
+	DatasetId types.String `tfsdk:"dataset_id"`
+	FileId types.String `tfsdk:"file_id"`
+	PolicyId types.String `tfsdk:"policy_id"`
+	PortalId types.String `tfsdk:"portal_id"`
+	RecordId types.String `tfsdk:"record_id"`
+	SecretId types.String `tfsdk:"secret_id"`
+	SessionId types.String `tfsdk:"session_id"`
+	SkillsetId types.String `tfsdk:"skillset_id"`
+	SpaceId types.String `tfsdk:"space_id"`
+	TaskId types.String `tfsdk:"task_id"`
+	WebhookId types.String `tfsdk:"webhook_id"`
+	OldValues types.String `tfsdk:"old_values"`
+	NewValues types.String `tfsdk:"new_values"`
+	Changes []AuditLogsDataSourceChangeModel `tfsdk:"changes"`
+	Meta types.Map `tfsdk:"meta"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// AuditLogsDataSourceChangeModel describes a field changed by an audited
+// action.
+type AuditLogsDataSourceChangeModel struct {
+	Field types.String `tfsdk:"field"`
+	OldValue types.String `tfsdk:"old_value"`
+	NewValue types.String `tfsdk:"new_value"`
+}
+
+// auditLogObjectAttributes lists the object ID attributes of an audit log
+// entry with their descriptions.
+var auditLogObjectAttributes = []struct {
+	name   string
+	object string
+}{
+	{"ability_id", "ability"},
+	{"blueprint_id", "blueprint"},
+	{"bot_id", "bot"},
+	{"contact_id", "contact"},
+	{"conversation_id", "conversation"},
+	{"dataset_id", "dataset"},
+	{"file_id", "file"},
+	{"policy_id", "policy"},
+	{"portal_id", "portal"},
+	{"record_id", "dataset record"},
+	{"secret_id", "secret"},
+	{"session_id", "session"},
+	{"skillset_id", "skillset"},
+	{"space_id", "space"},
+	{"task_id", "task"},
+	{"webhook_id", "webhook"},
+}
+
+// Metadata returns the data source type name.
+func (d *AuditLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_logs"
+}
+
+// Schema defines the schema for the data source.
+func (d *AuditLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	logAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The unique identifier of the audit log entry",
+			Computed:            true,
+		},
+		"action": schema.StringAttribute{
+			MarkdownDescription: "The action that was performed",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the audit log entry",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the audit log entry",
+			Computed:            true,
+		},
+		"ip_address": schema.StringAttribute{
+			MarkdownDescription: "The IP address the action was performed from",
+			Computed:            true,
+		},
+		"user_agent": schema.StringAttribute{
+			MarkdownDescription: "The user agent the action was performed with",
+			Computed:            true,
+		},
+		"old_values": schema.StringAttribute{
+			MarkdownDescription: "The values before the action, encoded as a JSON string",
+			Computed:            true,
+		},
+		"new_values": schema.StringAttribute{
+			MarkdownDescription: "The values after the action, encoded as a JSON string",
+			Computed:            true,
+		},
+		"changes": schema.ListNestedAttribute{
+			MarkdownDescription: "The fields whose value differs between `old_values` and `new_values`",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"field": schema.StringAttribute{
+						MarkdownDescription: "The name of the changed field",
+						Computed:            true,
+					},
+					"old_value": schema.StringAttribute{
+						MarkdownDescription: "The value before the action encoded as JSON, or null when the field was added",
+						Computed:            true,
+					},
+					"new_value": schema.StringAttribute{
+						MarkdownDescription: "The value after the action encoded as JSON, or null when the field was removed",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"meta": schema.MapAttribute{
+			MarkdownDescription: "Additional metadata for the audit log entry",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "Timestamp when the action was performed",
+			Computed:            true,
+		},
+	}
+	for _, attribute := range auditLogObjectAttributes {
+		logAttributes[attribute.name] = schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The ID of the %s the action was performed on, if any", attribute.object),
+			Computed:            true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to read the account audit log, optionally filtered by time window, action and object.",
+		Attributes: map[string]schema.Attribute{
+			"from": schema.StringAttribute{
+				MarkdownDescription: "Only return entries created at or after this RFC 3339 timestamp",
+				Optional:            true,
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "Only return entries created at or before this RFC 3339 timestamp",
+				Optional:            true,
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: "Only return entries for this action",
+				Optional:            true,
+			},
+			"bot_id": schema.StringAttribute{
+				MarkdownDescription: "Only return entries for this bot",
+				Optional:            true,
+			},
+			"dataset_id": schema.StringAttribute{
+				MarkdownDescription: "Only return entries for this dataset",
+				Optional:            true,
+			},
+			"secret_id": schema.StringAttribute{
+				MarkdownDescription: "Only return entries for this secret",
+				Optional:            true,
+			},
+			"skillset_id": schema.StringAttribute{
+				MarkdownDescription: "Only return entries for this skillset",
+				Optional:            true,
+			},
+			"portal_id": schema.StringAttribute{
+				MarkdownDescription: "Only return entries for this portal",
+				Optional:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of entries to return. The most recent entries are kept",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the matching entries",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"logs": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching entries, most recent first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: logAttributes,
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *AuditLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *AuditLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AuditLogsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window, diags := newTimeWindow(data.From, data.To)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdAt := func(item *AuditLogResponse) *string { return item.CreatedAt }
+	collector := &logCollector[AuditLogResponse]{
+		window:    window,
+		createdAt: createdAt,
+		matches:   func(item *AuditLogResponse) bool { return auditLogMatches(data, item) },
+	}
+
+	// Call the ChatBotKit GraphQL API to page through the audit log. Its order
+	// is not guaranteed, so every entry is visited
+	if err := d.client.ListAuditLogs(ctx, collector.visit); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list audit logs: %s", err))
+		return
+	}
+
+	// Update data model with the matching entries
+
+	matching := newestFirst(collector.items, createdAt, data.Limit)
+
+	ids := []string{}
+	data.Logs = []AuditLogsDataSourceLogModel{}
+	for _, item := range matching {
+		entry, diags := auditLogModel(ctx, item)
+		resp.Diagnostics.Append(diags...)
+
+		data.Logs = append(data.Logs, entry)
+		if item.ID != nil {
+			ids = append(ids, *item.ID)
+		}
+	}
+
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	data.IDs = idList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// auditLogMatches reports whether an audit log entry passes the configured
+// action and object filters.
+func auditLogMatches(data AuditLogsDataSourceModel, item *AuditLogResponse) bool {
+	for _, filter := range []struct {
+		configured types.String
+		value      *string
+	}{
+		{data.Action, item.Action},
+		{data.BotId, item.BotId},
+		{data.DatasetId, item.DatasetId},
+		{data.SecretId, item.SecretId},
+		{data.SkillsetId, item.SkillsetId},
+		{data.PortalId, item.PortalId},
+	} {
+		if !filter.configured.IsNull() && !equalStrings(filter.configured.ValueStringPointer(), filter.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// auditLogModel converts an audit log entry into its data source model.
+func auditLogModel(ctx context.Context, item *AuditLogResponse) (AuditLogsDataSourceLogModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	meta, d := metaValue(ctx, item.Meta)
+	diags.Append(d...)
+
+	oldValues, d := jsonStringValue(item.OldValues)
+	diags.Append(d...)
+
+	newValues, d := jsonStringValue(item.NewValues)
+	diags.Append(d...)
+
+	changes, d := auditLogChanges(item.OldValues, item.NewValues)
+	diags.Append(d...)
+
+	return AuditLogsDataSourceLogModel{
+		ID: types.StringPointerValue(item.ID),
+		Action: types.StringPointerValue(item.Action),
+		Name: types.StringPointerValue(item.Name),
+		Description: types.StringPointerValue(item.Description),
+		IpAddress: types.StringPointerValue(item.IpAddress),
+		UserAgent: types.StringPointerValue(item.UserAgent),
+		AbilityId: types.StringPointerValue(item.AbilityId),
+		BlueprintId: types.StringPointerValue(item.BlueprintId),
+		BotId: types.StringPointerValue(item.BotId),
+		ContactId: types.StringPointerValue(item.ContactId),
+		ConversationId: types.StringPointerValue(item.ConversationId),
+		DatasetId: types.StringPointerValue(item.DatasetId),
+		FileId: types.StringPointerValue(item.FileId),
+		PolicyId: types.StringPointerValue(item.PolicyId),
+		PortalId: types.StringPointerValue(item.PortalId),
+		RecordId: types.StringPointerValue(item.RecordId),
+		SecretId: types.StringPointerValue(item.SecretId),
+		SessionId: types.StringPointerValue(item.SessionId),
+		SkillsetId: types.StringPointerValue(item.SkillsetId),
+		SpaceId: types.StringPointerValue(item.SpaceId),
+		TaskId: types.StringPointerValue(item.TaskId),
+		WebhookId: types.StringPointerValue(item.WebhookId),
+		OldValues: oldValues,
+		NewValues: newValues,
+		Changes: changes,
+		Meta: meta,
+		CreatedAt: types.StringPointerValue(item.CreatedAt),
+	}, diags
+}
+
+// auditLogChanges returns the fields whose value differs between the old and
+// new values of an audit log entry, ordered by field name. Values are encoded
+// as JSON; a field missing on one side has a null value on that side.
+func auditLogChanges(oldValues map[string]interface{}, newValues map[string]interface{}) ([]AuditLogsDataSourceChangeModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	fields := map[string]bool{}
+	for field := range oldValues {
+		fields[field] = true
+	}
+	for field := range newValues {
+		fields[field] = true
+	}
+
+	names := make([]string, 0, len(fields))
+	for field := range fields {
+		names = append(names, field)
+	}
+	sort.Strings(names)
+
+	encode := func(values map[string]interface{}, field string) types.String {
+		value, ok := values[field]
+		if !ok {
+			return types.StringNull()
+		}
+
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			diags.AddError("Encoding Error", fmt.Sprintf("Unable to encode audit log field %q as JSON: %s", field, err))
+			return types.StringNull()
+		}
+		return types.StringValue(string(encoded))
+	}
+
+	changes := []AuditLogsDataSourceChangeModel{}
+	for _, field := range names {
+		oldValue, oldOk := oldValues[field]
+		newValue, newOk := newValues[field]
+		if oldOk == newOk && reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+
+		changes = append(changes, AuditLogsDataSourceChangeModel{
+			Field: types.StringValue(field),
+			OldValue: encode(oldValues, field),
+			NewValue: encode(newValues, field),
+		})
+	}
+
+	return changes, diags
+}
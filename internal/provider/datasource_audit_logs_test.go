@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// newLogServer serves a log connection oldest entry first, two entries per
+// page, with one entry per day starting on 2026-10-01. It returns the number
+// of pages requested.
+func newLogServer(t *testing.T, field string, days int) (*httptest.Server, *int) {
+	t.Helper()
+
+	pages := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		pages++
+
+		start := 0
+		if cursor, ok := body.Variables["cursor"].(string); ok {
+			fmt.Sscanf(cursor, "log_%d", &start)
+		}
+
+		edges := []map[string]interface{}{}
+		for day := start + 1; day <= days && day <= start+2; day++ {
+			id := fmt.Sprintf("log_%d", day)
+			edges = append(edges, map[string]interface{}{
+				"cursor": id,
+				"node":   map[string]interface{}{"id": id, "createdAt": fmt.Sprintf("2026-10-%02dT00:00:00Z", day)},
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				field: map[string]interface{}{
+					"edges":    edges,
+					"pageInfo": map[string]interface{}{"hasNextPage": start+2 < days},
+				},
+			},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &pages
+}
+
+func TestAuditLogsDataSourceRead(t *testing.T) {
+	server, pages := newLogServer(t, "auditLogs", 6)
+	d := &AuditLogsDataSource{client: NewClient("test-api-key", server.URL)}
+
+	config := testConfigValues(t, d, map[string]interface{}{"from": "2026-10-03T00:00:00Z", "limit": 2})
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema}}
+	d.Read(context.Background(), datasource.ReadRequest{Config: config}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("expected no error, got %v", resp.Diagnostics)
+	}
+
+	var data AuditLogsDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+
+	var ids []string
+	resp.Diagnostics.Append(data.IDs.ElementsAs(context.Background(), &ids, false)...)
+	if len(ids) != 2 || ids[0] != "log_6" || ids[1] != "log_5" {
+		t.Errorf("expected the 2 most recent entries, got %v", ids)
+	}
+	if *pages != 3 {
+		t.Errorf("expected every page to be requested, got %d", *pages)
+	}
+}
+
+func TestAuditLogChanges(t *testing.T) {
+	changes, diags := auditLogChanges(
+		map[string]interface{}{"name": "Support", "model": "gpt-4o", "meta": map[string]interface{}{"team": "support"}},
+		map[string]interface{}{"name": "Support", "model": "claude-4.5-sonnet", "meta": map[string]interface{}{"team": "support"}, "privacy": true},
+	)
+	if diags.HasError() {
+		t.Fatalf("expected no error, got %v", diags)
+	}
+
+	expected := []AuditLogsDataSourceChangeModel{
+		{Field: types.StringValue("model"), OldValue: types.StringValue(`"gpt-4o"`), NewValue: types.StringValue(`"claude-4.5-sonnet"`)},
+		{Field: types.StringValue("privacy"), OldValue: types.StringNull(), NewValue: types.StringValue("true")},
+	}
+	if len(changes) != len(expected) {
+		t.Fatalf("expected %d changes, got %v", len(expected), changes)
+	}
+	for i := range expected {
+		if !changes[i].Field.Equal(expected[i].Field) || !changes[i].OldValue.Equal(expected[i].OldValue) || !changes[i].NewValue.Equal(expected[i].NewValue) {
+			t.Errorf("expected change %v, got %v", expected[i], changes[i])
+		}
+	}
+}
+
+func TestAuditLogMatches(t *testing.T) {
+	item := &AuditLogResponse{Action: ptr("bot.update"), BotId: ptr("bot_1")}
+
+	if !auditLogMatches(AuditLogsDataSourceModel{Action: types.StringValue("bot.update"), BotId: types.StringValue("bot_1")}, item) {
+		t.Error("expected entry to match action and bot")
+	}
+	if auditLogMatches(AuditLogsDataSourceModel{BotId: types.StringValue("bot_2")}, item) {
+		t.Error("expected entry for another bot not to match")
+	}
+	if auditLogMatches(AuditLogsDataSourceModel{SecretId: types.StringValue("secret_1")}, item) {
+		t.Error("expected entry without a secret not to match")
+	}
+}
@@ -44,8 +44,9 @@ func (d *BlueprintDataSource) Schema(ctx context.Context, req datasource.SchemaR
 		MarkdownDescription: "Use this data source to get information about an existing blueprint.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The unique identifier of the blueprint to look up",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the blueprint to look up. Conflicts with `name`",
 			},
 
 			"description": schema.StringAttribute{
@@ -58,7 +59,8 @@ func (d *BlueprintDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				ElementType:         types.StringType,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the blueprint",
+				MarkdownDescription: "The name of the blueprint to look up. Conflicts with `id`",
+				Optional:            true,
 				Computed:            true,
 			},
 			"visibility": schema.StringAttribute{
@@ -106,13 +108,24 @@ func (d *BlueprintDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	// Call the ChatBotKit GraphQL API to read blueprint
-	result, err := d.client.GetBlueprint(ctx, data.ID.ValueString())
+	// Call the ChatBotKit GraphQL API to look up blueprint by ID or name
+	items, err := d.client.ListBlueprints(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read blueprint: %s", err))
 		return
 	}
 
+	result, diags := lookupOne(items, "blueprint", data.ID, data.Name, types.StringNull(), func(item *GetBlueprintResponse) (*string, *string, *string) {
+		return item.ID, item.Name, nil
+	})
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringPointerValue(result.ID)
+
 	// Update data model with response values
 
 	if result.Description != nil {
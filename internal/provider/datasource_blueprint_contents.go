@@ -0,0 +1,378 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BlueprintContentsDataSource{}
+
+func NewBlueprintContentsDataSource() datasource.DataSource {
+	return &BlueprintContentsDataSource{}
+}
+
+// BlueprintContentsDataSource defines the data source implementation.
+type BlueprintContentsDataSource struct {
+	client *Client
+}
+
+// BlueprintContentsDataSourceModel describes the data source data model.
+type BlueprintContentsDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	Name types.String `tfsdk:"name"`
+	Bots []BlueprintContentsDataSourceObjectModel `tfsdk:"bots"`
+	Datasets []BlueprintContentsDataSourceObjectModel `tfsdk:"datasets"`
+	Files []BlueprintContentsDataSourceObjectModel `tfsdk:"files"`
+	Portals []BlueprintContentsDataSourceObjectModel `tfsdk:"portals"`
+	Secrets []BlueprintContentsDataSourceObjectModel `tfsdk:"secrets"`
+	Skillsets []BlueprintContentsDataSourceObjectModel `tfsdk:"skillsets"`
+	Spaces []BlueprintContentsDataSourceObjectModel `tfsdk:"spaces"`
+	Tasks []BlueprintContentsDataSourceObjectModel `tfsdk:"tasks"`
+	Integrations []BlueprintContentsDataSourceIntegrationModel `tfsdk:"integrations"`
+}
+
+// BlueprintContentsDataSourceObjectModel describes an object in a blueprint.
+type BlueprintContentsDataSourceObjectModel struct {
+	ID types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+// BlueprintContentsDataSourceIntegrationModel describes an integration in a
+// blueprint.
+type BlueprintContentsDataSourceIntegrationModel struct {
+	ID types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+// blueprintContentGroups lists the object groups of a blueprint with the
+// singular name of their objects.
+var blueprintContentGroups = []struct {
+	name   string
+	object string
+}{
+	{"bots", "bot"},
+	{"datasets", "dataset"},
+	{"files", "file"},
+	{"portals", "portal"},
+	{"secrets", "secret"},
+	{"skillsets", "skillset"},
+	{"spaces", "space"},
+	{"tasks", "task"},
+}
+
+// Metadata returns the data source type name.
+func (d *BlueprintContentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprint_contents"
+}
+
+// Schema defines the schema for the data source.
+func (d *BlueprintContentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the blueprint",
+			Computed:            true,
+		},
+
+		"blueprint_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the blueprint to list the contents of",
+			Required:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the blueprint",
+			Computed:            true,
+		},
+		"integrations": schema.ListNestedAttribute{
+			MarkdownDescription: "The integrations in the blueprint, ordered by type and name",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "The unique identifier of the integration",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the integration",
+						Computed:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The type of the integration, for example `slack` or `mcpserver`",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+	for _, group := range blueprintContentGroups {
+		attributes[group.name] = schema.ListNestedAttribute{
+			MarkdownDescription: fmt.Sprintf("The %s in the blueprint, ordered by name", group.name),
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: fmt.Sprintf("The unique identifier of the %s", group.object),
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: fmt.Sprintf("The name of the %s", group.object),
+						Computed:            true,
+					},
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list every object in a blueprint, grouped by type.",
+		Attributes:          attributes,
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *BlueprintContentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *BlueprintContentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BlueprintContentsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	blueprintId := data.BlueprintId.ValueString()
+
+	// Call the ChatBotKit GraphQL API to read the blueprint
+	blueprint, err := d.client.GetBlueprint(ctx, blueprintId)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read blueprint: %s", err))
+		return
+	}
+
+	data.ID = data.BlueprintId
+	data.Name = types.StringPointerValue(blueprint.Name)
+
+	// The API has no listing by blueprint, so list every object type and keep
+	// the objects in the blueprint
+
+	groups := []struct {
+		name string
+		into *[]BlueprintContentsDataSourceObjectModel
+		list func() ([]BlueprintContentsDataSourceObjectModel, error)
+	}{
+		{"bots", &data.Bots, func() ([]BlueprintContentsDataSourceObjectModel, error) {
+			items, err := d.client.ListBots(ctx)
+			return inBlueprint(blueprintId, items, err, func(item *GetBotResponse) (*string, *string, *string) {
+				return item.ID, item.Name, item.BlueprintId
+			})
+		}},
+		{"datasets", &data.Datasets, func() ([]BlueprintContentsDataSourceObjectModel, error) {
+			items, err := d.client.ListDatasets(ctx)
+			return inBlueprint(blueprintId, items, err, func(item *GetDatasetResponse) (*string, *string, *string) {
+				return item.ID, item.Name, item.BlueprintId
+			})
+		}},
+		{"files", &data.Files, func() ([]BlueprintContentsDataSourceObjectModel, error) {
+			items, err := d.client.ListFiles(ctx)
+			return inBlueprint(blueprintId, items, err, func(item *GetFileResponse) (*string, *string, *string) {
+				return item.ID, item.Name, item.BlueprintId
+			})
+		}},
+		{"portals", &data.Portals, func() ([]BlueprintContentsDataSourceObjectModel, error) {
+			items, err := d.client.ListPortals(ctx)
+			return inBlueprint(blueprintId, items, err, func(item *GetPortalResponse) (*string, *string, *string) {
+				return item.ID, item.Name, item.BlueprintId
+			})
+		}},
+		{"secrets", &data.Secrets, func() ([]BlueprintContentsDataSourceObjectModel, error) {
+			items, err := d.client.ListSecrets(ctx)
+			return inBlueprint(blueprintId, items, err, func(item *GetSecretResponse) (*string, *string, *string) {
+				return item.ID, item.Name, item.BlueprintId
+			})
+		}},
+		{"skillsets", &data.Skillsets, func() ([]BlueprintContentsDataSourceObjectModel, error) {
+			items, err := d.client.ListSkillsets(ctx)
+			return inBlueprint(blueprintId, items, err, func(item *GetSkillsetResponse) (*string, *string, *string) {
+				return item.ID, item.Name, item.BlueprintId
+			})
+		}},
+		{"spaces", &data.Spaces, func() ([]BlueprintContentsDataSourceObjectModel, error) {
+			items, err := d.client.ListSpaces(ctx)
+			return inBlueprint(blueprintId, items, err, func(item *GetSpaceResponse) (*string, *string, *string) {
+				return item.ID, item.Name, item.BlueprintId
+			})
+		}},
+		{"tasks", &data.Tasks, func() ([]BlueprintContentsDataSourceObjectModel, error) {
+			items, err := d.client.ListTasks(ctx)
+			return inBlueprint(blueprintId, items, err, func(item *GetTaskResponse) (*string, *string, *string) {
+				return item.ID, item.Name, item.BlueprintId
+			})
+		}},
+	}
+
+	for _, group := range groups {
+		objects, err := group.list()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list %s: %s", group.name, err))
+			return
+		}
+		*group.into = objects
+	}
+
+	integrations := []struct {
+		integrationType string
+		list            func() ([]BlueprintContentsDataSourceObjectModel, error)
+	}{
+		{"discord", func() ([]BlueprintContentsDataSourceObjectModel, error) {
+			items, err := d.client.ListDiscordIntegrations(ctx)
+			return inBlueprint(blueprintId, items, err, func(item *GetDiscordIntegrationResponse) (*string, *string, *string) {
+				return item.ID, item.Name, item.BlueprintId
+			})
+		}},
+		{"email", func() ([]BlueprintContentsDataSourceObjectModel, error) {
+			items, err := d.client.ListEmailIntegrations(ctx)
+			return inBlueprint(blueprintId, items, err, func(item *GetEmailIntegrationResponse) (*string, *string, *string) {
+				return item.ID, item.Name, item.BlueprintId
+			})
+		}},
+		{"extract", func() ([]BlueprintContentsDataSourceObjectModel, error) {
+			items, err := d.client.ListExtractIntegrations(ctx)
+			return inBlueprint(blueprintId, items, err, func(item *GetExtractIntegrationResponse) (*string, *string, *string) {
+				return item.ID, item.Name, item.BlueprintId
+			})
+		}},
+		{"mcpserver", func() ([]BlueprintContentsDataSourceObjectModel, error) {
+			items, err := d.client.ListMcpserverIntegrations(ctx)
+			return inBlueprint(blueprintId, items, err, func(item *GetMcpserverIntegrationResponse) (*string, *string, *string) {
+				return item.ID, item.Name, item.BlueprintId
+			})
+		}},
+		{"messenger", func() ([]BlueprintContentsDataSourceObjectModel, error) {
+			items, err := d.client.ListMessengerIntegrations(ctx)
+			return inBlueprint(blueprintId, items, err, func(item *GetMessengerIntegrationResponse) (*string, *string, *string) {
+				return item.ID, item.Name, item.BlueprintId
+			})
+		}},
+		{"notion", func() ([]BlueprintContentsDataSourceObjectModel, error) {
+			items, err := d.client.ListNotionIntegrations(ctx)
+			return inBlueprint(blueprintId, items, err, func(item *GetNotionIntegrationResponse) (*string, *string, *string) {
+				return item.ID, item.Name, item.BlueprintId
+			})
+		}},
+		{"sitemap", func() ([]BlueprintContentsDataSourceObjectModel, error) {
+			items, err := d.client.ListSitemapIntegrations(ctx)
+			return inBlueprint(blueprintId, items, err, func(item *GetSitemapIntegrationResponse) (*string, *string, *string) {
+				return item.ID, item.Name, item.BlueprintId
+			})
+		}},
+		{"slack", func() ([]BlueprintContentsDataSourceObjectModel, error) {
+			items, err := d.client.ListSlackIntegrations(ctx)
+			return inBlueprint(blueprintId, items, err, func(item *GetSlackIntegrationResponse) (*string, *string, *string) {
+				return item.ID, item.Name, item.BlueprintId
+			})
+		}},
+		{"telegram", func() ([]BlueprintContentsDataSourceObjectModel, error) {
+			items, err := d.client.ListTelegramIntegrations(ctx)
+			return inBlueprint(blueprintId, items, err, func(item *GetTelegramIntegrationResponse) (*string, *string, *string) {
+				return item.ID, item.Name, item.BlueprintId
+			})
+		}},
+		{"trigger", func() ([]BlueprintContentsDataSourceObjectModel, error) {
+			items, err := d.client.ListTriggerIntegrations(ctx)
+			return inBlueprint(blueprintId, items, err, func(item *GetTriggerIntegrationResponse) (*string, *string, *string) {
+				return item.ID, item.Name, item.BlueprintId
+			})
+		}},
+		{"twilio", func() ([]BlueprintContentsDataSourceObjectModel, error) {
+			items, err := d.client.ListTwilioIntegrations(ctx)
+			return inBlueprint(blueprintId, items, err, func(item *GetTwilioIntegrationResponse) (*string, *string, *string) {
+				return item.ID, item.Name, item.BlueprintId
+			})
+		}},
+		{"whatsapp", func() ([]BlueprintContentsDataSourceObjectModel, error) {
+			items, err := d.client.ListWhatsAppIntegrations(ctx)
+			return inBlueprint(blueprintId, items, err, func(item *GetWhatsAppIntegrationResponse) (*string, *string, *string) {
+				return item.ID, item.Name, item.BlueprintId
+			})
+		}},
+	}
+
+	data.Integrations = []BlueprintContentsDataSourceIntegrationModel{}
+	for _, integration := range integrations {
+		objects, err := integration.list()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list %s integrations: %s", integration.integrationType, err))
+			return
+		}
+		for _, object := range objects {
+			data.Integrations = append(data.Integrations, BlueprintContentsDataSourceIntegrationModel{
+				ID: object.ID,
+				Name: object.Name,
+				Type: types.StringValue(integration.integrationType),
+			})
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// inBlueprint returns the objects that belong to the given blueprint, ordered
+// by name and then by ID. A listing error is passed through so that callers
+// can forward the result of a list call directly.
+func inBlueprint[T any](blueprintId string, items []*T, err error, attrs lookupAttrs[T]) ([]BlueprintContentsDataSourceObjectModel, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []BlueprintContentsDataSourceObjectModel{}
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+
+		id, name, itemBlueprintId := attrs(item)
+		if itemBlueprintId == nil || *itemBlueprintId != blueprintId {
+			continue
+		}
+
+		objects = append(objects, BlueprintContentsDataSourceObjectModel{
+			ID: types.StringPointerValue(id),
+			Name: types.StringPointerValue(name),
+		})
+	}
+
+	sort.SliceStable(objects, func(i, j int) bool {
+		if objects[i].Name.ValueString() != objects[j].Name.ValueString() {
+			return objects[i].Name.ValueString() < objects[j].Name.ValueString()
+		}
+		return objects[i].ID.ValueString() < objects[j].ID.ValueString()
+	})
+
+	return objects, nil
+}
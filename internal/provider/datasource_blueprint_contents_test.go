@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestInBlueprint(t *testing.T) {
+	attrs := func(item *GetBotResponse) (*string, *string, *string) {
+		return item.ID, item.Name, item.BlueprintId
+	}
+
+	t.Run("keeps objects in the blueprint ordered by name", func(t *testing.T) {
+		objects, err := inBlueprint("blueprint_1", []*GetBotResponse{
+			{ID: ptr("bot_3"), Name: ptr("Support"), BlueprintId: ptr("blueprint_1")},
+			{ID: ptr("bot_2"), Name: ptr("Sales"), BlueprintId: ptr("blueprint_2")},
+			{ID: ptr("bot_1"), Name: ptr("Onboarding"), BlueprintId: ptr("blueprint_1")},
+			{ID: ptr("bot_4"), Name: ptr("Unassigned")},
+			nil,
+		}, nil, attrs)
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(objects) != 2 {
+			t.Fatalf("expected 2 objects, got %v", objects)
+		}
+		if objects[0].ID.ValueString() != "bot_1" || objects[1].ID.ValueString() != "bot_3" {
+			t.Errorf("expected bot_1 then bot_3, got %v", objects)
+		}
+	})
+
+	t.Run("passes listing errors through", func(t *testing.T) {
+		_, err := inBlueprint[GetBotResponse]("blueprint_1", nil, errors.New("boom"), attrs)
+
+		if err == nil || err.Error() != "boom" {
+			t.Errorf("expected listing error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BlueprintsDataSource{}
+
+func NewBlueprintsDataSource() datasource.DataSource {
+	return &BlueprintsDataSource{}
+}
+
+// BlueprintsDataSource defines the data source implementation.
+type BlueprintsDataSource struct {
+	client *Client
+}
+
+// BlueprintsDataSourceModel describes the data source data model.
+type BlueprintsDataSourceModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	Visibility types.String `tfsdk:"visibility"`
+	Meta types.Map `tfsdk:"meta"`
+	IDs types.List `tfsdk:"ids"`
+	Blueprints []BlueprintsDataSourceBlueprintModel `tfsdk:"blueprints"`
+}
+
+// BlueprintsDataSourceBlueprintModel describes a blueprint returned by the data source.
+type BlueprintsDataSourceBlueprintModel struct {
+	ID types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Visibility types.String `tfsdk:"visibility"`
+	Meta types.Map `tfsdk:"meta"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *BlueprintsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprints"
+}
+
+// Schema defines the schema for the data source.
+func (d *BlueprintsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := listFilterAttributes("blueprints", false)
+
+	attributes["ids"] = schema.ListAttribute{
+		MarkdownDescription: "The IDs of the matching blueprints",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["blueprints"] = schema.ListNestedAttribute{
+		MarkdownDescription: "The matching blueprints",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+							"id": schema.StringAttribute{
+								MarkdownDescription: "The unique identifier of the blueprint",
+								Computed:            true,
+							},
+							"name": schema.StringAttribute{
+								MarkdownDescription: "The name of the blueprint",
+								Computed:            true,
+							},
+							"description": schema.StringAttribute{
+								MarkdownDescription: "The description of the blueprint",
+								Computed:            true,
+							},
+							"visibility": schema.StringAttribute{
+								MarkdownDescription: "The visibility level of the blueprint",
+								Computed:            true,
+							},
+							"meta": schema.MapAttribute{
+								MarkdownDescription: "Additional metadata for the blueprint",
+								Computed:            true,
+								ElementType:         types.StringType,
+							},
+							"created_at": schema.StringAttribute{
+								MarkdownDescription: "Timestamp when the blueprint was created",
+								Computed:            true,
+							},
+							"updated_at": schema.StringAttribute{
+								MarkdownDescription: "Timestamp when the blueprint was last updated",
+								Computed:            true,
+							},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list existing blueprints, optionally filtered.",
+		Attributes:          attributes,
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *BlueprintsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *BlueprintsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BlueprintsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newListFilter(ctx, data.NameRegex, data.Visibility, types.StringNull(), data.Meta)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit GraphQL API to list blueprints
+	result, err := d.client.ListBlueprints(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list blueprints: %s", err))
+		return
+	}
+
+	// Update data model with the matching blueprints
+
+	ids := []string{}
+	data.Blueprints = []BlueprintsDataSourceBlueprintModel{}
+	for _, item := range result {
+		if item == nil || !filter.matches(item.Name, item.Visibility, nil, item.Meta) {
+			continue
+		}
+
+		meta, diags := metaValue(ctx, item.Meta)
+		resp.Diagnostics.Append(diags...)
+
+		data.Blueprints = append(data.Blueprints, BlueprintsDataSourceBlueprintModel{
+			ID: types.StringPointerValue(item.ID),
+			Name: types.StringPointerValue(item.Name),
+			Description: types.StringPointerValue(item.Description),
+			Visibility: types.StringPointerValue(item.Visibility),
+			CreatedAt: types.StringPointerValue(item.CreatedAt),
+			UpdatedAt: types.StringPointerValue(item.UpdatedAt),
+			Meta: meta,
+		})
+		if item.ID != nil {
+			ids = append(ids, *item.ID)
+		}
+	}
+
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	data.IDs = idList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
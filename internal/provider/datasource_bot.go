@@ -51,8 +51,9 @@ func (d *BotDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 		MarkdownDescription: "Use this data source to get information about an existing bot.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The unique identifier of the bot to look up",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the bot to look up. Conflicts with `name`",
 			},
 
 			"backstory": schema.StringAttribute{
@@ -60,7 +61,8 @@ func (d *BotDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 				Computed:            true,
 			},
 			"blueprint_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the blueprint to use",
+				MarkdownDescription: "The ID of the blueprint the bot belongs to. When set, the lookup only considers bots in this blueprint",
+				Optional:            true,
 				Computed:            true,
 			},
 			"dataset_id": schema.StringAttribute{
@@ -85,7 +87,8 @@ func (d *BotDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the bot",
+				MarkdownDescription: "The name of the bot to look up. Conflicts with `id`",
+				Optional:            true,
 				Computed:            true,
 			},
 			"privacy": schema.BoolAttribute{
@@ -141,13 +144,24 @@ func (d *BotDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		return
 	}
 
-	// Call the ChatBotKit GraphQL API to read bot
-	result, err := d.client.GetBot(ctx, data.ID.ValueString())
+	// Call the ChatBotKit GraphQL API to look up bot by ID or name
+	items, err := d.client.ListBots(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read bot: %s", err))
 		return
 	}
 
+	result, diags := lookupOne(items, "bot", data.ID, data.Name, data.BlueprintId, func(item *GetBotResponse) (*string, *string, *string) {
+		return item.ID, item.Name, item.BlueprintId
+	})
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringPointerValue(result.ID)
+
 	// Update data model with response values
 
 	if result.Backstory != nil {
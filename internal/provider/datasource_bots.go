@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BotsDataSource{}
+
+func NewBotsDataSource() datasource.DataSource {
+	return &BotsDataSource{}
+}
+
+// BotsDataSource defines the data source implementation.
+type BotsDataSource struct {
+	client *Client
+}
+
+// BotsDataSourceModel describes the data source data model.
+type BotsDataSourceModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	Visibility types.String `tfsdk:"visibility"`
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	Meta types.Map `tfsdk:"meta"`
+	IDs types.List `tfsdk:"ids"`
+	Bots []BotsDataSourceBotModel `tfsdk:"bots"`
+}
+
+// BotsDataSourceBotModel describes a bot returned by the data source.
+type BotsDataSourceBotModel struct {
+	ID types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Backstory types.String `tfsdk:"backstory"`
+	Model types.String `tfsdk:"model"`
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	DatasetId types.String `tfsdk:"dataset_id"`
+	SkillsetId types.String `tfsdk:"skillset_id"`
+	Moderation types.Bool `tfsdk:"moderation"`
+	Privacy types.Bool `tfsdk:"privacy"`
+	Visibility types.String `tfsdk:"visibility"`
+	Meta types.Map `tfsdk:"meta"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *BotsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bots"
+}
+
+// Schema defines the schema for the data source.
+func (d *BotsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := listFilterAttributes("bots", true)
+
+	attributes["ids"] = schema.ListAttribute{
+		MarkdownDescription: "The IDs of the matching bots",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["bots"] = schema.ListNestedAttribute{
+		MarkdownDescription: "The matching bots",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+							"id": schema.StringAttribute{
+								MarkdownDescription: "The unique identifier of the bot",
+								Computed:            true,
+							},
+							"name": schema.StringAttribute{
+								MarkdownDescription: "The name of the bot",
+								Computed:            true,
+							},
+							"description": schema.StringAttribute{
+								MarkdownDescription: "The description of the bot",
+								Computed:            true,
+							},
+							"backstory": schema.StringAttribute{
+								MarkdownDescription: "The backstory for the bot",
+								Computed:            true,
+							},
+							"model": schema.StringAttribute{
+								MarkdownDescription: "The AI model used by the bot",
+								Computed:            true,
+							},
+							"blueprint_id": schema.StringAttribute{
+								MarkdownDescription: "The ID of the blueprint the bot belongs to",
+								Computed:            true,
+							},
+							"dataset_id": schema.StringAttribute{
+								MarkdownDescription: "The ID of the dataset used by the bot",
+								Computed:            true,
+							},
+							"skillset_id": schema.StringAttribute{
+								MarkdownDescription: "The ID of the skillset used by the bot",
+								Computed:            true,
+							},
+							"moderation": schema.BoolAttribute{
+								MarkdownDescription: "Whether moderation is enabled",
+								Computed:            true,
+							},
+							"privacy": schema.BoolAttribute{
+								MarkdownDescription: "Whether privacy mode is enabled",
+								Computed:            true,
+							},
+							"visibility": schema.StringAttribute{
+								MarkdownDescription: "The visibility level of the bot",
+								Computed:            true,
+							},
+							"meta": schema.MapAttribute{
+								MarkdownDescription: "Additional metadata for the bot",
+								Computed:            true,
+								ElementType:         types.StringType,
+							},
+							"created_at": schema.StringAttribute{
+								MarkdownDescription: "Timestamp when the bot was created",
+								Computed:            true,
+							},
+							"updated_at": schema.StringAttribute{
+								MarkdownDescription: "Timestamp when the bot was last updated",
+								Computed:            true,
+							},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list existing bots, optionally filtered.",
+		Attributes:          attributes,
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *BotsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *BotsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BotsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newListFilter(ctx, data.NameRegex, data.Visibility, data.BlueprintId, data.Meta)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit GraphQL API to list bots
+	result, err := d.client.ListBots(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list bots: %s", err))
+		return
+	}
+
+	// Update data model with the matching bots
+
+	ids := []string{}
+	data.Bots = []BotsDataSourceBotModel{}
+	for _, item := range result {
+		if item == nil || !filter.matches(item.Name, item.Visibility, item.BlueprintId, item.Meta) {
+			continue
+		}
+
+		meta, diags := metaValue(ctx, item.Meta)
+		resp.Diagnostics.Append(diags...)
+
+		data.Bots = append(data.Bots, BotsDataSourceBotModel{
+			ID: types.StringPointerValue(item.ID),
+			Name: types.StringPointerValue(item.Name),
+			Description: types.StringPointerValue(item.Description),
+			Backstory: types.StringPointerValue(item.Backstory),
+			Model: types.StringPointerValue(item.Model),
+			BlueprintId: types.StringPointerValue(item.BlueprintId),
+			DatasetId: types.StringPointerValue(item.DatasetId),
+			SkillsetId: types.StringPointerValue(item.SkillsetId),
+			Moderation: types.BoolPointerValue(item.Moderation),
+			Privacy: types.BoolPointerValue(item.Privacy),
+			Visibility: types.StringPointerValue(item.Visibility),
+			CreatedAt: types.StringPointerValue(item.CreatedAt),
+			UpdatedAt: types.StringPointerValue(item.UpdatedAt),
+			Meta: meta,
+		})
+		if item.ID != nil {
+			ids = append(ids, *item.ID)
+		}
+	}
+
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	data.IDs = idList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
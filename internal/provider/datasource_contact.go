@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ContactDataSource{}
+
+func NewContactDataSource() datasource.DataSource {
+	return &ContactDataSource{}
+}
+
+// ContactDataSource defines the data source implementation.
+type ContactDataSource struct {
+	client *Client
+}
+
+// ContactDataSourceModel describes the data source data model.
+type ContactDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	Description types.String `tfsdk:"description"`
+	Email types.String `tfsdk:"email"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+	Meta types.Map `tfsdk:"meta"`
+	Name types.String `tfsdk:"name"`
+	Nick types.String `tfsdk:"nick"`
+	Phone types.String `tfsdk:"phone"`
+	VerifiedAt types.String `tfsdk:"verified_at"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *ContactDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_contact"
+}
+
+// Schema defines the schema for the data source.
+func (d *ContactDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get information about an existing contact. Exactly one of `id`, `email`, `phone` or `fingerprint` must be set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the contact to look up",
+			},
+
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the contact",
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email of the contact to look up",
+				Optional:            true,
+				Computed:            true,
+			},
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: "The fingerprint of the contact to look up",
+				Optional:            true,
+				Computed:            true,
+			},
+			"meta": schema.MapAttribute{
+				MarkdownDescription: "Additional metadata for the contact",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the contact",
+				Computed:            true,
+			},
+			"nick": schema.StringAttribute{
+				MarkdownDescription: "The nickname of the contact",
+				Computed:            true,
+			},
+			"phone": schema.StringAttribute{
+				MarkdownDescription: "The phone number of the contact to look up",
+				Optional:            true,
+				Computed:            true,
+			},
+			"verified_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the contact was verified",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was created",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ContactDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ContactDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ContactDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Work out which lookup key was configured
+	lookups := map[string]types.String{
+		"id":          data.ID,
+		"email":       data.Email,
+		"phone":       data.Phone,
+		"fingerprint": data.Fingerprint,
+	}
+
+	var key, value string
+	for k, v := range lookups {
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		if key != "" {
+			resp.Diagnostics.AddError(
+				"Invalid Contact Lookup",
+				"Only one of id, email, phone or fingerprint may be set.",
+			)
+			return
+		}
+		key, value = k, v.ValueString()
+	}
+
+	if key == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Contact Lookup",
+			"One of id, email, phone or fingerprint must be set.",
+		)
+		return
+	}
+
+	// Call the ChatBotKit API to read contacts
+	contacts, err := d.client.ListContacts(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read contact: %s", err))
+		return
+	}
+
+	result, diags := findContact(contacts, key, value)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update data model with response values
+
+	data.ID = types.StringPointerValue(result.ID)
+	data.Description = types.StringPointerValue(result.Description)
+	data.Email = types.StringPointerValue(result.Email)
+	data.Fingerprint = types.StringPointerValue(result.Fingerprint)
+	if result.Meta != nil {
+		mapValue, diags := types.MapValueFrom(ctx, types.StringType, result.Meta)
+		resp.Diagnostics.Append(diags...)
+		data.Meta = mapValue
+	} else {
+		data.Meta = types.MapNull(types.StringType)
+	}
+	data.Name = types.StringPointerValue(result.Name)
+	data.Nick = types.StringPointerValue(result.Nick)
+	data.Phone = types.StringPointerValue(result.Phone)
+	data.VerifiedAt = types.StringPointerValue(result.VerifiedAt)
+	data.CreatedAt = types.StringPointerValue(result.CreatedAt)
+	data.UpdatedAt = types.StringPointerValue(result.UpdatedAt)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findContact returns the single contact whose id, email, phone or
+// fingerprint, as named by key, equals value. Zero or several matches are
+// reported as errors.
+func findContact(contacts []*GetContactResponse, key string, value string) (*GetContactResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var matches []*GetContactResponse
+	var matchIds []string
+	for _, contact := range contacts {
+		if contact == nil {
+			continue
+		}
+
+		var field *string
+		switch key {
+		case "id":
+			field = contact.ID
+		case "email":
+			field = contact.Email
+		case "phone":
+			field = contact.Phone
+		case "fingerprint":
+			field = contact.Fingerprint
+		}
+		if field == nil || *field != value {
+			continue
+		}
+
+		matches = append(matches, contact)
+		if contact.ID != nil {
+			matchIds = append(matchIds, *contact.ID)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddError(
+			"Contact Not Found",
+			fmt.Sprintf("No contact with %s %q was found.", key, value),
+		)
+		return nil, diags
+	case 1:
+		return matches[0], diags
+	default:
+		diags.AddError(
+			"Multiple Contacts Found",
+			fmt.Sprintf("Found %d contacts with %s %q: %s. Look the contact up by id.",
+				len(matches), key, value, strings.Join(matchIds, ", ")),
+		)
+		return nil, diags
+	}
+}
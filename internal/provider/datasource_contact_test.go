@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestFindContact(t *testing.T) {
+	contacts := []*GetContactResponse{
+		{ID: ptr("contact_1"), Email: ptr("vip@example.com"), Phone: ptr("+15550100")},
+		{ID: ptr("contact_2"), Email: ptr("shared@example.com"), Fingerprint: ptr("fp_1")},
+		{ID: ptr("contact_3"), Email: ptr("shared@example.com")},
+		nil,
+	}
+
+	tests := map[string]struct {
+		key       string
+		value     string
+		expected  string
+		expectErr string
+	}{
+		"by id":                 {key: "id", value: "contact_3", expected: "contact_3"},
+		"by email":              {key: "email", value: "vip@example.com", expected: "contact_1"},
+		"by phone":              {key: "phone", value: "+15550100", expected: "contact_1"},
+		"by fingerprint":        {key: "fingerprint", value: "fp_1", expected: "contact_2"},
+		"no match":              {key: "email", value: "nobody@example.com", expectErr: "Contact Not Found"},
+		"several matches":       {key: "email", value: "shared@example.com", expectErr: "Multiple Contacts Found"},
+		"unset field not match": {key: "fingerprint", value: "", expectErr: "Contact Not Found"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, diags := findContact(contacts, test.key, test.value)
+
+			if test.expectErr != "" {
+				if !diags.HasError() || diags.Errors()[0].Summary() != test.expectErr {
+					t.Errorf("expected error '%s', got %v", test.expectErr, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("expected no error, got %v", diags)
+			}
+			if got == nil || *got.ID != test.expected {
+				t.Errorf("expected '%s', got %v", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,504 @@
+package provider
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                   = &ConversationsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &ConversationsDataSource{}
+)
+
+func NewConversationsDataSource() datasource.DataSource {
+	return &ConversationsDataSource{}
+}
+
+// ConversationsDataSource defines the data source implementation.
+type ConversationsDataSource struct {
+	client *Client
+}
+
+// ConversationsDataSourceModel describes the data source data model.
+type ConversationsDataSourceModel struct {
+	BotId types.String `tfsdk:"bot_id"`
+	ContactId types.String `tfsdk:"contact_id"`
+	TaskId types.String `tfsdk:"task_id"`
+	From types.String `tfsdk:"from"`
+	To types.String `tfsdk:"to"`
+	Limit types.Int64 `tfsdk:"limit"`
+	ExportPath types.String `tfsdk:"export_path"`
+	IDs types.List `tfsdk:"ids"`
+	Conversations []ConversationsDataSourceConversationModel `tfsdk:"conversations"`
+}
+
+// ConversationsDataSourceConversationModel describes a conversation returned
+// by the data source.
+type ConversationsDataSourceConversationModel struct {
+	ID types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	BotId types.String `tfsdk:"bot_id"`
+	ContactId types.String `tfsdk:"contact_id"`
+	SpaceId types.String `tfsdk:"space_id"`
+	TaskId types.String `tfsdk:"task_id"`
+	Meta types.Map `tfsdk:"meta"`
+	Messages []ConversationsDataSourceMessageModel `tfsdk:"messages"`
+	Ratings []ConversationsDataSourceRatingModel `tfsdk:"ratings"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// ConversationsDataSourceMessageModel describes a message of a conversation
+// returned by the data source.
+type ConversationsDataSourceMessageModel struct {
+	ID types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+	Text types.String `tfsdk:"text"`
+	Meta types.Map `tfsdk:"meta"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// ConversationsDataSourceRatingModel describes a rating of a conversation
+// returned by the data source.
+type ConversationsDataSourceRatingModel struct {
+	ID types.String `tfsdk:"id"`
+	Value types.Int64 `tfsdk:"value"`
+	Reason types.String `tfsdk:"reason"`
+	MessageId types.String `tfsdk:"message_id"`
+	ContactId types.String `tfsdk:"contact_id"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// conversationExport is a single line of the JSONL export file.
+type conversationExport struct {
+	*GetConversationResponse
+	Messages []*ConversationMessageResponse `json:"messages"`
+	Ratings []*RatingResponse `json:"ratings"`
+}
+
+// Metadata returns the data source type name.
+func (d *ConversationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_conversations"
+}
+
+// Schema defines the schema for the data source.
+func (d *ConversationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to export conversations with their messages and ratings, for example for QA review or fine-tuning datasets.",
+		Attributes: map[string]schema.Attribute{
+			"bot_id": schema.StringAttribute{
+				MarkdownDescription: "Only return conversations with this bot",
+				Optional:            true,
+			},
+			"contact_id": schema.StringAttribute{
+				MarkdownDescription: "Only return conversations with this contact",
+				Optional:            true,
+			},
+			"task_id": schema.StringAttribute{
+				MarkdownDescription: "Only return conversations started by this task",
+				Optional:            true,
+			},
+			"from": schema.StringAttribute{
+				MarkdownDescription: "Only return conversations created at or after this RFC 3339 timestamp. At least one of `from` and `limit` must be set",
+				Optional:            true,
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "Only return conversations created at or before this RFC 3339 timestamp",
+				Optional:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of conversations to return. The most recent conversations are kept. At least one of `from` and `limit` must be set",
+				Optional:            true,
+			},
+			"export_path": schema.StringAttribute{
+				MarkdownDescription: "A local file path to write the matching conversations to as JSON Lines, one conversation per line. The file is overwritten on every read and restricted to the current user",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the matching conversations",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"conversations": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching conversations, most recent first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the conversation",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the conversation",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the conversation",
+							Computed:            true,
+						},
+						"bot_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the bot of the conversation",
+							Computed:            true,
+						},
+						"contact_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the contact of the conversation",
+							Computed:            true,
+						},
+						"space_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the space of the conversation",
+							Computed:            true,
+						},
+						"task_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the task that started the conversation",
+							Computed:            true,
+						},
+						"meta": schema.MapAttribute{
+							MarkdownDescription: "Additional metadata for the conversation",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"messages": schema.ListNestedAttribute{
+							MarkdownDescription: "The messages of the conversation, oldest first",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: "The unique identifier of the message",
+										Computed:            true,
+									},
+									"type": schema.StringAttribute{
+										MarkdownDescription: "The type of the message. One of `" + strings.Join(messageTypes, "`, `") + "`",
+										Computed:            true,
+									},
+									"text": schema.StringAttribute{
+										MarkdownDescription: "The text of the message",
+										Computed:            true,
+									},
+									"meta": schema.MapAttribute{
+										MarkdownDescription: "Additional metadata for the message",
+										Computed:            true,
+										ElementType:         types.StringType,
+									},
+									"created_at": schema.StringAttribute{
+										MarkdownDescription: "Timestamp when the message was created",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"ratings": schema.ListNestedAttribute{
+							MarkdownDescription: "The ratings of the conversation and its messages, oldest first",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: "The unique identifier of the rating",
+										Computed:            true,
+									},
+									"value": schema.Int64Attribute{
+										MarkdownDescription: "The value of the rating",
+										Computed:            true,
+									},
+									"reason": schema.StringAttribute{
+										MarkdownDescription: "The reason given for the rating",
+										Computed:            true,
+									},
+									"message_id": schema.StringAttribute{
+										MarkdownDescription: "The ID of the rated message, or null when the whole conversation was rated",
+										Computed:            true,
+									},
+									"contact_id": schema.StringAttribute{
+										MarkdownDescription: "The ID of the contact who gave the rating",
+										Computed:            true,
+									},
+									"created_at": schema.StringAttribute{
+										MarkdownDescription: "Timestamp when the rating was created",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp when the conversation was created",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp when the conversation was last updated",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the read is bounded, as every returned
+// conversation costs a further request for its messages.
+func (d *ConversationsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data ConversationsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.From.IsNull() && data.Limit.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("limit"),
+			"Unbounded Conversation Read",
+			"Reading every conversation of the account requires a request per conversation. Set from, limit or both to bound the read.",
+		)
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ConversationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ConversationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ConversationsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window, diags := newTimeWindow(data.From, data.To)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdAt := func(item *GetConversationResponse) *string { return item.CreatedAt }
+	collector := &logCollector[GetConversationResponse]{
+		window:    window,
+		limit:     data.Limit,
+		ordered:   true,
+		createdAt: createdAt,
+		matches:   func(item *GetConversationResponse) bool { return conversationMatches(data, item) },
+	}
+
+	// Call the ChatBotKit API to page through the conversations, stopping as
+	// soon as the remaining conversations cannot match
+	if err := d.client.ListConversations(ctx, collector.visit); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list conversations: %s", err))
+		return
+	}
+
+	matching := newestFirst(collector.items, createdAt, data.Limit)
+
+	// Ratings cannot be listed per conversation, so page through them once
+	// and group them by conversation. A rating is never older than its
+	// conversation, so paging stops at the oldest matching conversation
+	ratings := map[string][]*RatingResponse{}
+	if len(matching) > 0 {
+		oldest := parseTimestamp(matching[len(matching)-1].CreatedAt)
+		err := d.client.ListRatings(ctx, func(rating *RatingResponse) bool {
+			if created := parseTimestamp(rating.CreatedAt); !oldest.IsZero() && !created.IsZero() && created.Before(oldest) {
+				return false
+			}
+			if rating.ConversationId != nil {
+				ratings[*rating.ConversationId] = append(ratings[*rating.ConversationId], rating)
+			}
+			return true
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list ratings: %s", err))
+			return
+		}
+
+		// Ratings are reported oldest first
+		for _, group := range ratings {
+			slices.Reverse(group)
+		}
+	}
+
+	// Update data model with the matching conversations
+
+	var exports []conversationExport
+	ids := []string{}
+	data.Conversations = []ConversationsDataSourceConversationModel{}
+	for _, item := range matching {
+		if item.ID == nil {
+			continue
+		}
+
+		messages, err := d.client.ListConversationMessages(ctx, *item.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list messages of conversation %s: %s", *item.ID, err))
+			return
+		}
+
+		conversation, diags := conversationModel(ctx, item, messages, ratings[*item.ID])
+		resp.Diagnostics.Append(diags...)
+
+		data.Conversations = append(data.Conversations, conversation)
+		ids = append(ids, *item.ID)
+		exports = append(exports, conversationExport{
+			GetConversationResponse: item,
+			Messages: messages,
+			Ratings: ratings[*item.ID],
+		})
+	}
+
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	data.IDs = idList
+
+	if !data.ExportPath.IsNull() {
+		if err := writeConversationExport(data.ExportPath.ValueString(), exports); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("export_path"),
+				"Export Error",
+				fmt.Sprintf("Unable to write conversation export: %s", err),
+			)
+			return
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// conversationMatches reports whether a conversation passes the configured
+// bot, contact and task filters.
+func conversationMatches(data ConversationsDataSourceModel, item *GetConversationResponse) bool {
+	for _, filter := range []struct {
+		configured types.String
+		value      *string
+	}{
+		{data.BotId, item.BotId},
+		{data.ContactId, item.ContactId},
+		{data.TaskId, item.TaskId},
+	} {
+		if !filter.configured.IsNull() && !equalStrings(filter.configured.ValueStringPointer(), filter.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// conversationModel converts a conversation with its messages and ratings into
+// its data source model.
+func conversationModel(ctx context.Context, item *GetConversationResponse, messages []*ConversationMessageResponse, ratings []*RatingResponse) (ConversationsDataSourceConversationModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	meta, d := metaValue(ctx, item.Meta)
+	diags.Append(d...)
+
+	conversation := ConversationsDataSourceConversationModel{
+		ID: types.StringPointerValue(item.ID),
+		Name: types.StringPointerValue(item.Name),
+		Description: types.StringPointerValue(item.Description),
+		BotId: types.StringPointerValue(item.BotId),
+		ContactId: types.StringPointerValue(item.ContactId),
+		SpaceId: types.StringPointerValue(item.SpaceId),
+		TaskId: types.StringPointerValue(item.TaskId),
+		Meta: meta,
+		Messages: []ConversationsDataSourceMessageModel{},
+		Ratings: []ConversationsDataSourceRatingModel{},
+		CreatedAt: types.StringPointerValue(item.CreatedAt),
+		UpdatedAt: types.StringPointerValue(item.UpdatedAt),
+	}
+
+	for _, message := range messages {
+		if message == nil {
+			continue
+		}
+
+		messageMeta, d := metaValue(ctx, message.Meta)
+		diags.Append(d...)
+
+		conversation.Messages = append(conversation.Messages, ConversationsDataSourceMessageModel{
+			ID: types.StringPointerValue(message.ID),
+			Type: types.StringPointerValue(message.Type),
+			Text: types.StringPointerValue(message.Text),
+			Meta: messageMeta,
+			CreatedAt: types.StringPointerValue(message.CreatedAt),
+		})
+	}
+
+	for _, rating := range ratings {
+		conversation.Ratings = append(conversation.Ratings, ConversationsDataSourceRatingModel{
+			ID: types.StringPointerValue(rating.ID),
+			Value: types.Int64PointerValue(rating.Value),
+			Reason: types.StringPointerValue(rating.Reason),
+			MessageId: types.StringPointerValue(rating.MessageId),
+			ContactId: types.StringPointerValue(rating.ContactId),
+			CreatedAt: types.StringPointerValue(rating.CreatedAt),
+		})
+	}
+
+	return conversation, diags
+}
+
+// writeConversationExport writes conversations to filename as JSON Lines, one
+// conversation per line. Missing parent directories are created. The file is
+// only readable by the current user since conversations may contain personal
+// data.
+func writeConversationExport(filename string, conversations []conversationExport) error {
+	if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+
+	// The mode only applies to new files, restrict an existing export too as
+	// it holds conversation transcripts
+	if err := file.Chmod(0o600); err != nil {
+		file.Close()
+		return err
+	}
+
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	for _, conversation := range conversations {
+		if err := encoder.Encode(conversation); err != nil {
+			file.Close()
+			return err
+		}
+	}
+
+	if err := writer.Flush(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
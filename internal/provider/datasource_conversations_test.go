@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestConversationMatches(t *testing.T) {
+	item := &GetConversationResponse{ID: ptr("conv_1"), BotId: ptr("bot_1"), ContactId: ptr("contact_1")}
+
+	tests := map[string]struct {
+		data     ConversationsDataSourceModel
+		expected bool
+	}{
+		"no filters": {
+			data:     ConversationsDataSourceModel{},
+			expected: true,
+		},
+		"bot and contact match": {
+			data:     ConversationsDataSourceModel{BotId: types.StringValue("bot_1"), ContactId: types.StringValue("contact_1")},
+			expected: true,
+		},
+		"bot differs": {
+			data: ConversationsDataSourceModel{BotId: types.StringValue("bot_2")},
+		},
+		"task is missing": {
+			data: ConversationsDataSourceModel{TaskId: types.StringValue("task_1")},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := conversationMatches(test.data, item); got != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestWriteConversationExport(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "exports", "conversations.jsonl")
+
+	// An existing export readable by others is restricted when overwritten
+	if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filename, []byte("stale\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := writeConversationExport(filename, []conversationExport{
+		{
+			GetConversationResponse: &GetConversationResponse{ID: ptr("conv_1"), BotId: ptr("bot_1")},
+			Messages: []*ConversationMessageResponse{
+				{ID: ptr("msg_1"), Type: ptr("user"), Text: ptr("Hi")},
+				{ID: ptr("msg_2"), Type: ptr("bot"), Text: ptr("Hello!")},
+			},
+			Ratings: []*RatingResponse{{ID: ptr("rating_1"), Value: int64Ptr(1), MessageId: ptr("msg_2")}},
+		},
+		{
+			GetConversationResponse: &GetConversationResponse{ID: ptr("conv_2")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("expected export file, got %v", err)
+	}
+
+	info, err := os.Stat(filename)
+	if err != nil {
+		t.Fatalf("expected export file, got %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("expected export file mode 0600, got %v", info.Mode().Perm())
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d: %s", len(lines), content)
+	}
+
+	var first map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &first); err != nil {
+		t.Fatalf("expected JSON line, got %v", err)
+	}
+	if first["id"] != "conv_1" || first["botId"] != "bot_1" {
+		t.Errorf("expected conversation fields at the top level, got %v", first)
+	}
+	if messages, ok := first["messages"].([]interface{}); !ok || len(messages) != 2 {
+		t.Errorf("expected 2 messages, got %v", first["messages"])
+	}
+	if ratings, ok := first["ratings"].([]interface{}); !ok || len(ratings) != 1 {
+		t.Errorf("expected 1 rating, got %v", first["ratings"])
+	}
+}
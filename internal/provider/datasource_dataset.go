@@ -54,12 +54,14 @@ func (d *DatasetDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 		MarkdownDescription: "Use this data source to get information about an existing dataset.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The unique identifier of the dataset to look up",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the dataset to look up. Conflicts with `name`",
 			},
 
 			"blueprint_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the blueprint to use",
+				MarkdownDescription: "The ID of the blueprint the dataset belongs to. When set, the lookup only considers datasets in this blueprint",
+				Optional:            true,
 				Computed:            true,
 			},
 			"description": schema.StringAttribute{
@@ -80,7 +82,8 @@ func (d *DatasetDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the dataset",
+				MarkdownDescription: "The name of the dataset to look up. Conflicts with `id`",
+				Optional:            true,
 				Computed:            true,
 			},
 			"record_max_tokens": schema.Int64Attribute{
@@ -156,13 +159,24 @@ func (d *DatasetDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	// Call the ChatBotKit GraphQL API to read dataset
-	result, err := d.client.GetDataset(ctx, data.ID.ValueString())
+	// Call the ChatBotKit GraphQL API to look up dataset by ID or name
+	items, err := d.client.ListDatasets(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dataset: %s", err))
 		return
 	}
 
+	result, diags := lookupOne(items, "dataset", data.ID, data.Name, data.BlueprintId, func(item *GetDatasetResponse) (*string, *string, *string) {
+		return item.ID, item.Name, item.BlueprintId
+	})
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringPointerValue(result.ID)
+
 	// Update data model with response values
 
 	if result.BlueprintId != nil {
@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DatasetSearchDataSource{}
+
+func NewDatasetSearchDataSource() datasource.DataSource {
+	return &DatasetSearchDataSource{}
+}
+
+// DatasetSearchDataSource defines the data source implementation.
+type DatasetSearchDataSource struct {
+	client *Client
+}
+
+// DatasetSearchDataSourceModel describes the data source data model.
+type DatasetSearchDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	DatasetId types.String `tfsdk:"dataset_id"`
+	Query types.String `tfsdk:"query"`
+	MinScore types.Float64 `tfsdk:"min_score"`
+	MaxRecords types.Int64 `tfsdk:"max_records"`
+	IDs types.List `tfsdk:"ids"`
+	TopScore types.Float64 `tfsdk:"top_score"`
+	Records []DatasetSearchDataSourceRecordModel `tfsdk:"records"`
+}
+
+// DatasetSearchDataSourceRecordModel describes a record matched by the search.
+type DatasetSearchDataSourceRecordModel struct {
+	ID types.String `tfsdk:"id"`
+	Text types.String `tfsdk:"text"`
+	Score types.Float64 `tfsdk:"score"`
+	Meta types.Map `tfsdk:"meta"`
+}
+
+// Metadata returns the data source type name.
+func (d *DatasetSearchDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataset_search"
+}
+
+// Schema defines the schema for the data source.
+func (d *DatasetSearchDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to run a search query against a dataset and inspect the matched records and their scores.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the searched dataset",
+				Computed:            true,
+			},
+
+			"dataset_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the dataset to search",
+				Required:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "The search query",
+				Required:            true,
+			},
+			"min_score": schema.Float64Attribute{
+				MarkdownDescription: "Only return records with at least this score. Applied on top of the `search_min_score` of the dataset",
+				Optional:            true,
+			},
+			"max_records": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of records to return. Applied on top of the `search_max_records` of the dataset",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the matched records, best match first",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"top_score": schema.Float64Attribute{
+				MarkdownDescription: "The score of the best match. Null when no record matched",
+				Computed:            true,
+			},
+			"records": schema.ListNestedAttribute{
+				MarkdownDescription: "The matched records, best match first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the record",
+							Computed:            true,
+						},
+						"text": schema.StringAttribute{
+							MarkdownDescription: "The text of the record",
+							Computed:            true,
+						},
+						"score": schema.Float64Attribute{
+							MarkdownDescription: "The relevance score of the record for the query",
+							Computed:            true,
+						},
+						"meta": schema.MapAttribute{
+							MarkdownDescription: "Additional metadata for the record",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DatasetSearchDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DatasetSearchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DatasetSearchDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit API to search the dataset
+	result, err := d.client.SearchDataset(ctx, data.DatasetId.ValueString(), SearchDatasetInput{
+		Search: data.Query.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to search dataset: %s", err))
+		return
+	}
+
+	// Update data model with the matched records
+
+	data.ID = data.DatasetId
+
+	ids := []string{}
+	data.TopScore = types.Float64Null()
+	data.Records = []DatasetSearchDataSourceRecordModel{}
+	for _, item := range rankSearchRecords(result, data.MinScore, data.MaxRecords) {
+		meta, diags := metaValue(ctx, item.Meta)
+		resp.Diagnostics.Append(diags...)
+
+		if data.TopScore.IsNull() {
+			data.TopScore = types.Float64PointerValue(item.Score)
+		}
+
+		data.Records = append(data.Records, DatasetSearchDataSourceRecordModel{
+			ID: types.StringPointerValue(item.ID),
+			Text: types.StringPointerValue(item.Text),
+			Score: types.Float64PointerValue(item.Score),
+			Meta: meta,
+		})
+		if item.ID != nil {
+			ids = append(ids, *item.ID)
+		}
+	}
+
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	data.IDs = idList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// rankSearchRecords orders matched records by descending score, drops records
+// scoring below minScore and keeps at most maxRecords. Records without a
+// score are ranked last and dropped when minScore is set.
+func rankSearchRecords(records []*DatasetSearchRecordResponse, minScore types.Float64, maxRecords types.Int64) []*DatasetSearchRecordResponse {
+	var ranked []*DatasetSearchRecordResponse
+	for _, record := range records {
+		if record == nil {
+			continue
+		}
+		if !minScore.IsNull() && !minScore.IsUnknown() && (record.Score == nil || *record.Score < minScore.ValueFloat64()) {
+			continue
+		}
+		ranked = append(ranked, record)
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[j].Score == nil {
+			return ranked[i].Score != nil
+		}
+		return ranked[i].Score != nil && *ranked[i].Score > *ranked[j].Score
+	})
+
+	if !maxRecords.IsNull() && !maxRecords.IsUnknown() && maxRecords.ValueInt64() >= 0 && int64(len(ranked)) > maxRecords.ValueInt64() {
+		ranked = ranked[:maxRecords.ValueInt64()]
+	}
+	return ranked
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRankSearchRecords(t *testing.T) {
+	score := func(s float64) *float64 { return &s }
+	records := []*DatasetSearchRecordResponse{
+		{ID: ptr("low"), Score: score(0.2)},
+		{ID: ptr("unscored")},
+		{ID: ptr("high"), Score: score(0.9)},
+		nil,
+		{ID: ptr("mid"), Score: score(0.5)},
+	}
+
+	tests := map[string]struct {
+		minScore   types.Float64
+		maxRecords types.Int64
+		expected   []string
+	}{
+		"orders by score": {
+			minScore:   types.Float64Null(),
+			maxRecords: types.Int64Null(),
+			expected:   []string{"high", "mid", "low", "unscored"},
+		},
+		"drops low scores": {
+			minScore:   types.Float64Value(0.5),
+			maxRecords: types.Int64Null(),
+			expected:   []string{"high", "mid"},
+		},
+		"keeps the best matches": {
+			minScore:   types.Float64Null(),
+			maxRecords: types.Int64Value(2),
+			expected:   []string{"high", "mid"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			for _, record := range rankSearchRecords(records, test.minScore, test.maxRecords) {
+				got = append(got, *record.ID)
+			}
+			if len(got) != len(test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, got)
+			}
+			for i := range got {
+				if got[i] != test.expected[i] {
+					t.Errorf("expected %v, got %v", test.expected, got)
+					break
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DatasetsDataSource{}
+
+func NewDatasetsDataSource() datasource.DataSource {
+	return &DatasetsDataSource{}
+}
+
+// DatasetsDataSource defines the data source implementation.
+type DatasetsDataSource struct {
+	client *Client
+}
+
+// DatasetsDataSourceModel describes the data source data model.
+type DatasetsDataSourceModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	Visibility types.String `tfsdk:"visibility"`
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	Meta types.Map `tfsdk:"meta"`
+	IDs types.List `tfsdk:"ids"`
+	Datasets []DatasetsDataSourceDatasetModel `tfsdk:"datasets"`
+}
+
+// DatasetsDataSourceDatasetModel describes a dataset returned by the data source.
+type DatasetsDataSourceDatasetModel struct {
+	ID types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	Store types.String `tfsdk:"store"`
+	Reranker types.String `tfsdk:"reranker"`
+	Visibility types.String `tfsdk:"visibility"`
+	Meta types.Map `tfsdk:"meta"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *DatasetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_datasets"
+}
+
+// Schema defines the schema for the data source.
+func (d *DatasetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := listFilterAttributes("datasets", true)
+
+	attributes["ids"] = schema.ListAttribute{
+		MarkdownDescription: "The IDs of the matching datasets",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["datasets"] = schema.ListNestedAttribute{
+		MarkdownDescription: "The matching datasets",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+							"id": schema.StringAttribute{
+								MarkdownDescription: "The unique identifier of the dataset",
+								Computed:            true,
+							},
+							"name": schema.StringAttribute{
+								MarkdownDescription: "The name of the dataset",
+								Computed:            true,
+							},
+							"description": schema.StringAttribute{
+								MarkdownDescription: "The description of the dataset",
+								Computed:            true,
+							},
+							"blueprint_id": schema.StringAttribute{
+								MarkdownDescription: "The ID of the blueprint the dataset belongs to",
+								Computed:            true,
+							},
+							"store": schema.StringAttribute{
+								MarkdownDescription: "The storage backend of the dataset",
+								Computed:            true,
+							},
+							"reranker": schema.StringAttribute{
+								MarkdownDescription: "The reranker used by the dataset",
+								Computed:            true,
+							},
+							"visibility": schema.StringAttribute{
+								MarkdownDescription: "The visibility level of the dataset",
+								Computed:            true,
+							},
+							"meta": schema.MapAttribute{
+								MarkdownDescription: "Additional metadata for the dataset",
+								Computed:            true,
+								ElementType:         types.StringType,
+							},
+							"created_at": schema.StringAttribute{
+								MarkdownDescription: "Timestamp when the dataset was created",
+								Computed:            true,
+							},
+							"updated_at": schema.StringAttribute{
+								MarkdownDescription: "Timestamp when the dataset was last updated",
+								Computed:            true,
+							},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list existing datasets, optionally filtered.",
+		Attributes:          attributes,
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DatasetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DatasetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DatasetsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newListFilter(ctx, data.NameRegex, data.Visibility, data.BlueprintId, data.Meta)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit GraphQL API to list datasets
+	result, err := d.client.ListDatasets(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list datasets: %s", err))
+		return
+	}
+
+	// Update data model with the matching datasets
+
+	ids := []string{}
+	data.Datasets = []DatasetsDataSourceDatasetModel{}
+	for _, item := range result {
+		if item == nil || !filter.matches(item.Name, item.Visibility, item.BlueprintId, item.Meta) {
+			continue
+		}
+
+		meta, diags := metaValue(ctx, item.Meta)
+		resp.Diagnostics.Append(diags...)
+
+		data.Datasets = append(data.Datasets, DatasetsDataSourceDatasetModel{
+			ID: types.StringPointerValue(item.ID),
+			Name: types.StringPointerValue(item.Name),
+			Description: types.StringPointerValue(item.Description),
+			BlueprintId: types.StringPointerValue(item.BlueprintId),
+			Store: types.StringPointerValue(item.Store),
+			Reranker: types.StringPointerValue(item.Reranker),
+			Visibility: types.StringPointerValue(item.Visibility),
+			CreatedAt: types.StringPointerValue(item.CreatedAt),
+			UpdatedAt: types.StringPointerValue(item.UpdatedAt),
+			Meta: meta,
+		})
+		if item.ID != nil {
+			ids = append(ids, *item.ID)
+		}
+	}
+
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	data.IDs = idList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DiscordIntegrationDataSource{}
+
+func NewDiscordIntegrationDataSource() datasource.DataSource {
+	return &DiscordIntegrationDataSource{}
+}
+
+// DiscordIntegrationDataSource defines the data source implementation.
+type DiscordIntegrationDataSource struct {
+	client *Client
+}
+
+// DiscordIntegrationDataSourceModel describes the data source data model.
+type DiscordIntegrationDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	AppId types.String `tfsdk:"app_id"`
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	BotId types.String `tfsdk:"bot_id"`
+	BotToken types.String `tfsdk:"bot_token"`
+	ContactCollection types.Bool `tfsdk:"contact_collection"`
+	Description types.String `tfsdk:"description"`
+	Handle types.String `tfsdk:"handle"`
+	Meta types.Map `tfsdk:"meta"`
+	Name types.String `tfsdk:"name"`
+	PublicKey types.String `tfsdk:"public_key"`
+	SessionDuration types.Int64 `tfsdk:"session_duration"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *DiscordIntegrationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_discord_integration"
+}
+
+// Schema defines the schema for the data source.
+func (d *DiscordIntegrationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get information about an existing discord integration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the discord integration to look up. Conflicts with `name`",
+			},
+
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "The Discord application ID",
+				Computed:            true,
+			},
+			"blueprint_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the blueprint the discord integration belongs to. When set, the lookup only considers objects in this blueprint",
+				Optional:            true,
+				Computed:            true,
+			},
+			"bot_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the bot to connect",
+				Computed:            true,
+			},
+			"bot_token": schema.StringAttribute{
+				MarkdownDescription: "The Discord bot token for API access",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"contact_collection": schema.BoolAttribute{
+				MarkdownDescription: "Whether to collect contact information",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the integration",
+				Computed:            true,
+			},
+			"handle": schema.StringAttribute{
+				MarkdownDescription: "The bot handle or username",
+				Computed:            true,
+			},
+			"meta": schema.MapAttribute{
+				MarkdownDescription: "Additional metadata for the integration",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the discord integration to look up. Conflicts with `id`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"public_key": schema.StringAttribute{
+				MarkdownDescription: "The Discord public key for request verification",
+				Computed:            true,
+			},
+			"session_duration": schema.Int64Attribute{
+				MarkdownDescription: "The duration of the session in milliseconds",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was created",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DiscordIntegrationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DiscordIntegrationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DiscordIntegrationDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit GraphQL API to look up discord integration by ID or name
+	items, err := d.client.ListDiscordIntegrations(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read discord integration: %s", err))
+		return
+	}
+
+	result, diags := lookupOne(items, "discord integration", data.ID, data.Name, data.BlueprintId, func(item *GetDiscordIntegrationResponse) (*string, *string, *string) {
+		return item.ID, item.Name, item.BlueprintId
+	})
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringPointerValue(result.ID)
+
+	// Update data model with response values
+
+	if result.AppId != nil {
+		data.AppId = types.StringPointerValue(result.AppId)
+	}
+	if result.BlueprintId != nil {
+		data.BlueprintId = types.StringPointerValue(result.BlueprintId)
+	}
+	if result.BotId != nil {
+		data.BotId = types.StringPointerValue(result.BotId)
+	}
+	if result.BotToken != nil {
+		data.BotToken = types.StringPointerValue(result.BotToken)
+	}
+	if result.ContactCollection != nil {
+		data.ContactCollection = types.BoolPointerValue(result.ContactCollection)
+	}
+	if result.Description != nil {
+		data.Description = types.StringPointerValue(result.Description)
+	}
+	if result.Handle != nil {
+		data.Handle = types.StringPointerValue(result.Handle)
+	}
+	if result.Meta != nil {
+		mapValue, diags := types.MapValueFrom(ctx, types.StringType, result.Meta)
+		resp.Diagnostics.Append(diags...)
+		data.Meta = mapValue
+	}
+	if result.Name != nil {
+		data.Name = types.StringPointerValue(result.Name)
+	}
+	if result.PublicKey != nil {
+		data.PublicKey = types.StringPointerValue(result.PublicKey)
+	}
+	if result.SessionDuration != nil {
+		data.SessionDuration = types.Int64PointerValue(result.SessionDuration)
+	}
+	if result.CreatedAt != nil {
+		data.CreatedAt = types.StringPointerValue(result.CreatedAt)
+	}
+	if result.UpdatedAt != nil {
+		data.UpdatedAt = types.StringPointerValue(result.UpdatedAt)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
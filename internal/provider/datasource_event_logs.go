@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &EventLogsDataSource{}
+
+func NewEventLogsDataSource() datasource.DataSource {
+	return &EventLogsDataSource{}
+}
+
+// EventLogsDataSource defines the data source implementation.
+type EventLogsDataSource struct {
+	client *Client
+}
+
+// EventLogsDataSourceModel describes the data source data model.
+type EventLogsDataSourceModel struct {
+	Type types.String `tfsdk:"type"`
+	From types.String `tfsdk:"from"`
+	To types.String `tfsdk:"to"`
+	BotId types.String `tfsdk:"bot_id"`
+	ConversationId types.String `tfsdk:"conversation_id"`
+	TaskId types.String `tfsdk:"task_id"`
+	AbilityId types.String `tfsdk:"ability_id"`
+	SecretId types.String `tfsdk:"secret_id"`
+	Limit types.Int64 `tfsdk:"limit"`
+	IDs types.List `tfsdk:"ids"`
+	Logs []EventLogsDataSourceLogModel `tfsdk:"logs"`
+}
+
+// EventLogsDataSourceLogModel describes an event log entry returned by the
+// data source.
+type EventLogsDataSourceLogModel struct {
+	ID types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+	Name types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	AbilityId types.String `tfsdk:"ability_id"`
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	BotId types.String `tfsdk:"bot_id"`
+	ContactId types.String `tfsdk:"contact_id"`
+	ConversationId types.String `tfsdk:"conversation_id"`
+	DatasetId types.String `tfsdk:"dataset_id"`
+	FileId types.String `tfsdk:"file_id"`
+	RecordId types.String `tfsdk:"record_id"`
+	SecretId types.String `tfsdk:"secret_id"`
+	SkillsetId types.String `tfsdk:"skillset_id"`
+	SpaceId types.String `tfsdk:"space_id"`
+	TaskId types.String `tfsdk:"task_id"`
+	Meta types.Map `tfsdk:"meta"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// eventLogObjectAttributes lists the object ID attributes of an event log
+// entry with their descriptions.
+var eventLogObjectAttributes = []struct {
+	name   string
+	object string
+}{
+	{"ability_id", "ability"},
+	{"blueprint_id", "blueprint"},
+	{"bot_id", "bot"},
+	{"contact_id", "contact"},
+	{"conversation_id", "conversation"},
+	{"dataset_id", "dataset"},
+	{"file_id", "file"},
+	{"record_id", "dataset record"},
+	{"secret_id", "secret"},
+	{"skillset_id", "skillset"},
+	{"space_id", "space"},
+	{"task_id", "task"},
+}
+
+// Metadata returns the data source type name.
+func (d *EventLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_event_logs"
+}
+
+// Schema defines the schema for the data source.
+func (d *EventLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	logAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The unique identifier of the event log entry",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "The type of the event",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the event log entry",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the event log entry",
+			Computed:            true,
+		},
+		"meta": schema.MapAttribute{
+			MarkdownDescription: "Additional metadata for the event log entry",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "Timestamp when the event occurred",
+			Computed:            true,
+		},
+	}
+	for _, attribute := range eventLogObjectAttributes {
+		logAttributes[attribute.name] = schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The ID of the %s related to the event, if any", attribute.object),
+			Computed:            true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to read the account event log, optionally filtered by type, time window and related object.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return events of this type",
+				Optional:            true,
+			},
+			"from": schema.StringAttribute{
+				MarkdownDescription: "Only return events that occurred at or after this RFC 3339 timestamp",
+				Optional:            true,
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "Only return events that occurred at or before this RFC 3339 timestamp",
+				Optional:            true,
+			},
+			"bot_id": schema.StringAttribute{
+				MarkdownDescription: "Only return events related to this bot",
+				Optional:            true,
+			},
+			"conversation_id": schema.StringAttribute{
+				MarkdownDescription: "Only return events related to this conversation",
+				Optional:            true,
+			},
+			"task_id": schema.StringAttribute{
+				MarkdownDescription: "Only return events related to this task",
+				Optional:            true,
+			},
+			"ability_id": schema.StringAttribute{
+				MarkdownDescription: "Only return events related to this ability",
+				Optional:            true,
+			},
+			"secret_id": schema.StringAttribute{
+				MarkdownDescription: "Only return events related to this secret",
+				Optional:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of events to return. The most recent events are kept",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the matching events",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"logs": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching events, most recent first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: logAttributes,
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *EventLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *EventLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EventLogsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window, diags := newTimeWindow(data.From, data.To)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdAt := func(item *EventLogResponse) *string { return item.CreatedAt }
+	collector := &logCollector[EventLogResponse]{
+		window:    window,
+		createdAt: createdAt,
+		matches:   func(item *EventLogResponse) bool { return eventLogMatches(data, item) },
+	}
+
+	// Call the ChatBotKit GraphQL API to page through the event log. Its order
+	// is not guaranteed, so every event is visited
+	if err := d.client.ListEventLogs(ctx, collector.visit); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list event logs: %s", err))
+		return
+	}
+
+	// Update data model with the matching events
+
+	matching := newestFirst(collector.items, createdAt, data.Limit)
+
+	ids := []string{}
+	data.Logs = []EventLogsDataSourceLogModel{}
+	for _, item := range matching {
+		meta, diags := metaValue(ctx, item.Meta)
+		resp.Diagnostics.Append(diags...)
+
+		data.Logs = append(data.Logs, EventLogsDataSourceLogModel{
+			ID: types.StringPointerValue(item.ID),
+			Type: types.StringPointerValue(item.Type),
+			Name: types.StringPointerValue(item.Name),
+			Description: types.StringPointerValue(item.Description),
+			AbilityId: types.StringPointerValue(item.AbilityId),
+			BlueprintId: types.StringPointerValue(item.BlueprintId),
+			BotId: types.StringPointerValue(item.BotId),
+			ContactId: types.StringPointerValue(item.ContactId),
+			ConversationId: types.StringPointerValue(item.ConversationId),
+			DatasetId: types.StringPointerValue(item.DatasetId),
+			FileId: types.StringPointerValue(item.FileId),
+			RecordId: types.StringPointerValue(item.RecordId),
+			SecretId: types.StringPointerValue(item.SecretId),
+			SkillsetId: types.StringPointerValue(item.SkillsetId),
+			SpaceId: types.StringPointerValue(item.SpaceId),
+			TaskId: types.StringPointerValue(item.TaskId),
+			Meta: meta,
+			CreatedAt: types.StringPointerValue(item.CreatedAt),
+		})
+		if item.ID != nil {
+			ids = append(ids, *item.ID)
+		}
+	}
+
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	data.IDs = idList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// eventLogMatches reports whether an event log entry passes the configured
+// type and object filters.
+func eventLogMatches(data EventLogsDataSourceModel, item *EventLogResponse) bool {
+	for _, filter := range []struct {
+		configured types.String
+		value      *string
+	}{
+		{data.Type, item.Type},
+		{data.BotId, item.BotId},
+		{data.ConversationId, item.ConversationId},
+		{data.TaskId, item.TaskId},
+		{data.AbilityId, item.AbilityId},
+		{data.SecretId, item.SecretId},
+	} {
+		if !filter.configured.IsNull() && !equalStrings(filter.configured.ValueStringPointer(), filter.value) {
+			return false
+		}
+	}
+	return true
+}
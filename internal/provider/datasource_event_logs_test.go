@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEventLogsDataSourceRead(t *testing.T) {
+	server, pages := newLogServer(t, "eventLogs", 6)
+	d := &EventLogsDataSource{client: NewClient("test-api-key", server.URL)}
+
+	config := testConfigValues(t, d, map[string]interface{}{"from": "2026-10-03T00:00:00Z", "limit": 2})
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema}}
+	d.Read(context.Background(), datasource.ReadRequest{Config: config}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("expected no error, got %v", resp.Diagnostics)
+	}
+
+	var data EventLogsDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+
+	var ids []string
+	resp.Diagnostics.Append(data.IDs.ElementsAs(context.Background(), &ids, false)...)
+	if len(ids) != 2 || ids[0] != "log_6" || ids[1] != "log_5" {
+		t.Errorf("expected the 2 most recent events, got %v", ids)
+	}
+	if *pages != 3 {
+		t.Errorf("expected every page to be requested, got %d", *pages)
+	}
+}
+
+func TestEventLogMatches(t *testing.T) {
+	item := &EventLogResponse{Type: ptr("ability.error"), BotId: ptr("bot_1"), TaskId: ptr("task_1")}
+
+	tests := map[string]struct {
+		data     EventLogsDataSourceModel
+		expected bool
+	}{
+		"no filters": {
+			data:     EventLogsDataSourceModel{},
+			expected: true,
+		},
+		"type and task match": {
+			data:     EventLogsDataSourceModel{Type: types.StringValue("ability.error"), TaskId: types.StringValue("task_1")},
+			expected: true,
+		},
+		"type differs": {
+			data: EventLogsDataSourceModel{Type: types.StringValue("task.run")},
+		},
+		"conversation is missing": {
+			data: EventLogsDataSourceModel{ConversationId: types.StringValue("conversation_1")},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := eventLogMatches(test.data, item); got != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ExtractIntegrationDataSource{}
+
+func NewExtractIntegrationDataSource() datasource.DataSource {
+	return &ExtractIntegrationDataSource{}
+}
+
+// ExtractIntegrationDataSource defines the data source implementation.
+type ExtractIntegrationDataSource struct {
+	client *Client
+}
+
+// ExtractIntegrationDataSourceModel describes the data source data model.
+type ExtractIntegrationDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	BotId types.String `tfsdk:"bot_id"`
+	Description types.String `tfsdk:"description"`
+	Meta types.Map `tfsdk:"meta"`
+	Name types.String `tfsdk:"name"`
+	Request types.String `tfsdk:"request"`
+	Schema types.Map `tfsdk:"schema"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *ExtractIntegrationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_extract_integration"
+}
+
+// Schema defines the schema for the data source.
+func (d *ExtractIntegrationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get information about an existing extract integration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the extract integration to look up. Conflicts with `name`",
+			},
+
+			"blueprint_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the blueprint the extract integration belongs to. When set, the lookup only considers objects in this blueprint",
+				Optional:            true,
+				Computed:            true,
+			},
+			"bot_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the bot to connect",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the integration",
+				Computed:            true,
+			},
+			"meta": schema.MapAttribute{
+				MarkdownDescription: "Additional metadata for the integration",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the extract integration to look up. Conflicts with `id`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"request": schema.StringAttribute{
+				MarkdownDescription: "The webhook URL to send extracted data to",
+				Computed:            true,
+			},
+			"schema": schema.MapAttribute{
+				MarkdownDescription: "The JSON schema defining the data structure to extract",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was created",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ExtractIntegrationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ExtractIntegrationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ExtractIntegrationDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit GraphQL API to look up extract integration by ID or name
+	items, err := d.client.ListExtractIntegrations(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read extract integration: %s", err))
+		return
+	}
+
+	result, diags := lookupOne(items, "extract integration", data.ID, data.Name, data.BlueprintId, func(item *GetExtractIntegrationResponse) (*string, *string, *string) {
+		return item.ID, item.Name, item.BlueprintId
+	})
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringPointerValue(result.ID)
+
+	// Update data model with response values
+
+	if result.BlueprintId != nil {
+		data.BlueprintId = types.StringPointerValue(result.BlueprintId)
+	}
+	if result.BotId != nil {
+		data.BotId = types.StringPointerValue(result.BotId)
+	}
+	if result.Description != nil {
+		data.Description = types.StringPointerValue(result.Description)
+	}
+	if result.Meta != nil {
+		mapValue, diags := types.MapValueFrom(ctx, types.StringType, result.Meta)
+		resp.Diagnostics.Append(diags...)
+		data.Meta = mapValue
+	}
+	if result.Name != nil {
+		data.Name = types.StringPointerValue(result.Name)
+	}
+	if result.Request != nil {
+		data.Request = types.StringPointerValue(result.Request)
+	}
+	if result.Schema != nil {
+		mapValue, diags := types.MapValueFrom(ctx, types.StringType, result.Schema)
+		resp.Diagnostics.Append(diags...)
+		data.Schema = mapValue
+	}
+	if result.CreatedAt != nil {
+		data.CreatedAt = types.StringPointerValue(result.CreatedAt)
+	}
+	if result.UpdatedAt != nil {
+		data.UpdatedAt = types.StringPointerValue(result.UpdatedAt)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
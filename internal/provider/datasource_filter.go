@@ -0,0 +1,386 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listFilter selects the objects returned by the plural data sources.
+type listFilter struct {
+	nameRegex   *regexp.Regexp
+	visibility  string
+	blueprintId string
+	meta        map[string]string
+}
+
+// listFilterAttributes returns the filter arguments shared by the plural data
+// sources. entity is the plural name of the listed objects.
+func listFilterAttributes(entity string, withBlueprint bool) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"name_regex": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("A regular expression the name of the %s must match", entity),
+			Optional:            true,
+		},
+		"visibility": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Only return %s with this visibility level", entity),
+			Optional:            true,
+		},
+		"meta": schema.MapAttribute{
+			MarkdownDescription: fmt.Sprintf("Only return %s whose metadata contains all of these key/value pairs", entity),
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+	}
+	if withBlueprint {
+		attributes["blueprint_id"] = schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Only return %s that belong to this blueprint", entity),
+			Optional:            true,
+		}
+	}
+	return attributes
+}
+
+// newListFilter builds a filter from the configured filter arguments. Pass a
+// null blueprintId for objects that do not belong to blueprints.
+func newListFilter(ctx context.Context, nameRegex types.String, visibility types.String, blueprintId types.String, meta types.Map) (*listFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	filter := &listFilter{
+		visibility:  visibility.ValueString(),
+		blueprintId: blueprintId.ValueString(),
+	}
+
+	if !nameRegex.IsNull() {
+		re, err := regexp.Compile(nameRegex.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Name Regex",
+				fmt.Sprintf("The name_regex value is not a valid regular expression: %s", err),
+			)
+			return nil, diags
+		}
+		filter.nameRegex = re
+	}
+
+	if !meta.IsNull() {
+		diags.Append(meta.ElementsAs(ctx, &filter.meta, false)...)
+	}
+
+	return filter, diags
+}
+
+// matches reports whether an object with the given attributes passes the
+// filter.
+func (f *listFilter) matches(name *string, visibility *string, blueprintId *string, meta map[string]interface{}) bool {
+	if f.nameRegex != nil && (name == nil || !f.nameRegex.MatchString(*name)) {
+		return false
+	}
+	if f.visibility != "" && (visibility == nil || *visibility != f.visibility) {
+		return false
+	}
+	if f.blueprintId != "" && (blueprintId == nil || *blueprintId != f.blueprintId) {
+		return false
+	}
+	for key, value := range f.meta {
+		actual, ok := meta[key]
+		if !ok || fmt.Sprint(actual) != value {
+			return false
+		}
+	}
+	return true
+}
+
+// metaValue converts API metadata into a map of strings. Null is returned for
+// objects without metadata.
+func metaValue(ctx context.Context, meta map[string]interface{}) (types.Map, diag.Diagnostics) {
+	if meta == nil {
+		return types.MapNull(types.StringType), nil
+	}
+
+	values := make(map[string]string, len(meta))
+	for key, value := range meta {
+		values[key] = fmt.Sprint(value)
+	}
+
+	return types.MapValueFrom(ctx, types.StringType, values)
+}
+
+// lookupAttrs returns the attributes of an object used by lookupOne.
+type lookupAttrs[T any] func(item *T) (id *string, name *string, blueprintId *string)
+
+// lookupOne returns the single object matching the configured id or name,
+// optionally scoped to a blueprint. Exactly one of id and name must be set.
+// entity is the singular name of the object used in diagnostics.
+func lookupOne[T any](items []*T, entity string, id types.String, name types.String, blueprintId types.String, attrs lookupAttrs[T]) (*T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	title := strings.ToUpper(entity[:1]) + entity[1:]
+
+	hasId := !id.IsNull() && !id.IsUnknown()
+	hasName := !name.IsNull() && !name.IsUnknown()
+	if hasId == hasName {
+		diags.AddError(
+			fmt.Sprintf("Invalid %s Lookup", title),
+			"Exactly one of id or name must be set.",
+		)
+		return nil, diags
+	}
+
+	key, value := "ID", id.ValueString()
+	if hasName {
+		key, value = "name", name.ValueString()
+	}
+
+	scope := ""
+	if !blueprintId.IsNull() && !blueprintId.IsUnknown() {
+		scope = fmt.Sprintf(" in blueprint %s", blueprintId.ValueString())
+	}
+
+	var matches []*T
+	var matchIds []string
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+
+		itemId, itemName, itemBlueprintId := attrs(item)
+
+		candidate := itemId
+		if hasName {
+			candidate = itemName
+		}
+		if candidate == nil || *candidate != value {
+			continue
+		}
+		if scope != "" && (itemBlueprintId == nil || *itemBlueprintId != blueprintId.ValueString()) {
+			continue
+		}
+
+		matches = append(matches, item)
+		if itemId != nil {
+			matchIds = append(matchIds, *itemId)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddError(
+			fmt.Sprintf("%s Not Found", title),
+			fmt.Sprintf("No %s with %s %q was found%s.", entity, key, value, scope),
+		)
+		return nil, diags
+	case 1:
+		return matches[0], diags
+	default:
+		diags.AddError(
+			fmt.Sprintf("Multiple %ss Found", title),
+			fmt.Sprintf("Found %d %ss with %s %q%s: %s. Look the %s up by id or narrow the search with blueprint_id.",
+				len(matches), entity, key, value, scope, strings.Join(matchIds, ", "), entity),
+		)
+		return nil, diags
+	}
+}
+
+// jsonStringValue encodes an API object as a JSON string. Null is returned for
+// missing objects.
+func jsonStringValue(value map[string]interface{}) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value == nil {
+		return types.StringNull(), diags
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		diags.AddError("Encoding Error", fmt.Sprintf("Unable to encode value as JSON: %s", err))
+		return types.StringNull(), diags
+	}
+
+	return types.StringValue(string(encoded)), diags
+}
+
+// matchesFold reports whether value equals the configured string, ignoring
+// case. An unset configuration matches every value.
+func matchesFold(configured types.String, value *string) bool {
+	if configured.IsNull() || configured.IsUnknown() {
+		return true
+	}
+	return value != nil && strings.EqualFold(*value, configured.ValueString())
+}
+
+// atLeast reports whether value is at least the configured minimum. An unset
+// minimum matches every value.
+func atLeast(minimum types.Int64, value *int64) bool {
+	if minimum.IsNull() || minimum.IsUnknown() {
+		return true
+	}
+	return value != nil && *value >= minimum.ValueInt64()
+}
+
+// int64Value dereferences an optional integer, treating nil as zero.
+func int64Value(value *int64) int64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
+
+// stringValue dereferences an optional string, treating nil as empty.
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// hasTags reports whether tags contains every required tag, ignoring case.
+func hasTags(required []string, tags []string) bool {
+	for _, tag := range required {
+		found := false
+		for _, candidate := range tags {
+			if strings.EqualFold(candidate, tag) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// timeWindow selects log entries created within a time range. A zero bound
+// leaves that side of the range open.
+type timeWindow struct {
+	from time.Time
+	to   time.Time
+}
+
+// newTimeWindow builds a time window from the configured `from` and `to`
+// RFC 3339 timestamps.
+func newTimeWindow(from types.String, to types.String) (*timeWindow, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	window := &timeWindow{}
+	for _, bound := range []struct {
+		name  string
+		value types.String
+		into  *time.Time
+	}{
+		{"from", from, &window.from},
+		{"to", to, &window.to},
+	} {
+		if bound.value.IsNull() || bound.value.IsUnknown() {
+			continue
+		}
+
+		parsed, err := time.Parse(time.RFC3339, bound.value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root(bound.name),
+				"Invalid Timestamp",
+				fmt.Sprintf("The %s value must be an RFC 3339 timestamp such as 2026-01-02T15:04:05Z: %s", bound.name, err),
+			)
+			continue
+		}
+		*bound.into = parsed
+	}
+
+	if !window.from.IsZero() && !window.to.IsZero() && window.from.After(window.to) {
+		diags.AddAttributeError(
+			path.Root("from"),
+			"Invalid Time Window",
+			"The from timestamp must not be after the to timestamp.",
+		)
+	}
+
+	return window, diags
+}
+
+// contains reports whether a log entry created at createdAt falls within the
+// window. Entries without a valid timestamp only match an open window.
+func (w *timeWindow) contains(createdAt *string) bool {
+	if w.from.IsZero() && w.to.IsZero() {
+		return true
+	}
+	created := parseTimestamp(createdAt)
+	if created.IsZero() {
+		return false
+	}
+
+	if !w.from.IsZero() && created.Before(w.from) {
+		return false
+	}
+	if !w.to.IsZero() && created.After(w.to) {
+		return false
+	}
+	return true
+}
+
+// newestFirst orders log entries by creation time, most recent first, and
+// keeps at most limit entries. A null limit keeps every entry.
+func newestFirst[T any](items []*T, createdAt func(*T) *string, limit types.Int64) []*T {
+	sort.SliceStable(items, func(i, j int) bool {
+		return parseTimestamp(createdAt(items[i])).After(parseTimestamp(createdAt(items[j])))
+	})
+
+	if !limit.IsNull() && !limit.IsUnknown() && limit.ValueInt64() >= 0 && int64(len(items)) > limit.ValueInt64() {
+		items = items[:limit.ValueInt64()]
+	}
+	return items
+}
+
+// logCollector gathers the log entries matching a data source while the log
+// is paged. When the log is known to be paged most recent first, its visit
+// method tells the pager to stop once an entry predates the time window or
+// once limit matches have been collected, as no later page can contribute to
+// the result. Otherwise every entry is visited.
+type logCollector[T any] struct {
+	window    *timeWindow
+	limit     types.Int64
+	ordered   bool
+	createdAt func(*T) *string
+	matches   func(*T) bool
+	items     []*T
+}
+
+// visit records item when it matches and reports whether paging should
+// continue.
+func (l *logCollector[T]) visit(item *T) bool {
+	created := parseTimestamp(l.createdAt(item))
+	if l.ordered && !l.window.from.IsZero() && !created.IsZero() && created.Before(l.window.from) {
+		return false
+	}
+
+	if l.window.contains(l.createdAt(item)) && l.matches(item) {
+		l.items = append(l.items, item)
+	}
+
+	return !l.ordered || l.limit.IsNull() || l.limit.IsUnknown() || l.limit.ValueInt64() < 0 || int64(len(l.items)) < l.limit.ValueInt64()
+}
+
+// parseTimestamp parses an RFC 3339 timestamp returned by the API. The zero
+// time is returned for missing or invalid timestamps.
+func parseTimestamp(value *string) time.Time {
+	if value == nil {
+		return time.Time{}
+	}
+
+	parsed, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		return time.Time{}
+	}
+	return parsed
+}
@@ -0,0 +1,445 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestListFilter(t *testing.T) {
+	ctx := context.Background()
+
+	meta, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{"team": "support"})
+
+	filter, diags := newListFilter(ctx, types.StringValue("^shared-"), types.StringValue("public"), types.StringValue("blueprint_1"), meta)
+	if diags.HasError() {
+		t.Fatalf("expected no error, got %v", diags)
+	}
+
+	tests := map[string]struct {
+		name        *string
+		visibility  *string
+		blueprintId *string
+		meta        map[string]interface{}
+		expected    bool
+	}{
+		"all filters match": {
+			name:        ptr("shared-support"),
+			visibility:  ptr("public"),
+			blueprintId: ptr("blueprint_1"),
+			meta:        map[string]interface{}{"team": "support", "owner": "ops"},
+			expected:    true,
+		},
+		"name does not match": {
+			name:        ptr("private-support"),
+			visibility:  ptr("public"),
+			blueprintId: ptr("blueprint_1"),
+			meta:        map[string]interface{}{"team": "support"},
+		},
+		"visibility does not match": {
+			name:        ptr("shared-support"),
+			visibility:  ptr("private"),
+			blueprintId: ptr("blueprint_1"),
+			meta:        map[string]interface{}{"team": "support"},
+		},
+		"blueprint is missing": {
+			name:       ptr("shared-support"),
+			visibility: ptr("public"),
+			meta:       map[string]interface{}{"team": "support"},
+		},
+		"meta value differs": {
+			name:        ptr("shared-support"),
+			visibility:  ptr("public"),
+			blueprintId: ptr("blueprint_1"),
+			meta:        map[string]interface{}{"team": "sales"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := filter.matches(test.name, test.visibility, test.blueprintId, test.meta); got != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, got)
+			}
+		})
+	}
+
+	t.Run("empty filter matches everything", func(t *testing.T) {
+		empty, _ := newListFilter(ctx, types.StringNull(), types.StringNull(), types.StringNull(), types.MapNull(types.StringType))
+		if !empty.matches(nil, nil, nil, nil) {
+			t.Error("expected empty filter to match")
+		}
+	})
+
+	t.Run("invalid regex is reported", func(t *testing.T) {
+		_, diags := newListFilter(ctx, types.StringValue("("), types.StringNull(), types.StringNull(), types.MapNull(types.StringType))
+		if !diags.HasError() {
+			t.Error("expected invalid regex error")
+		}
+	})
+}
+
+func TestLookupOne(t *testing.T) {
+	items := []*GetBotResponse{
+		{ID: ptr("bot_1"), Name: ptr("support"), BlueprintId: ptr("blueprint_1")},
+		{ID: ptr("bot_2"), Name: ptr("support"), BlueprintId: ptr("blueprint_2")},
+		{ID: ptr("bot_3"), Name: ptr("sales")},
+	}
+	attrs := func(item *GetBotResponse) (*string, *string, *string) {
+		return item.ID, item.Name, item.BlueprintId
+	}
+
+	tests := map[string]struct {
+		id          types.String
+		name        types.String
+		blueprintId types.String
+		expectedId  string
+		expectedErr string
+	}{
+		"by id": {
+			id:         types.StringValue("bot_2"),
+			name:       types.StringNull(),
+			expectedId: "bot_2",
+		},
+		"by unique name": {
+			id:         types.StringNull(),
+			name:       types.StringValue("sales"),
+			expectedId: "bot_3",
+		},
+		"by name scoped to blueprint": {
+			id:          types.StringNull(),
+			name:        types.StringValue("support"),
+			blueprintId: types.StringValue("blueprint_2"),
+			expectedId:  "bot_2",
+		},
+		"ambiguous name": {
+			id:          types.StringNull(),
+			name:        types.StringValue("support"),
+			expectedErr: "Multiple Bots Found",
+		},
+		"no match": {
+			id:          types.StringNull(),
+			name:        types.StringValue("marketing"),
+			expectedErr: "Bot Not Found",
+		},
+		"id outside blueprint": {
+			id:          types.StringValue("bot_3"),
+			name:        types.StringNull(),
+			blueprintId: types.StringValue("blueprint_1"),
+			expectedErr: "Bot Not Found",
+		},
+		"both id and name": {
+			id:          types.StringValue("bot_1"),
+			name:        types.StringValue("support"),
+			expectedErr: "Invalid Bot Lookup",
+		},
+		"neither id nor name": {
+			id:          types.StringNull(),
+			name:        types.StringNull(),
+			expectedErr: "Invalid Bot Lookup",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, diags := lookupOne(items, "bot", test.id, test.name, test.blueprintId, attrs)
+
+			if test.expectedErr != "" {
+				if !diags.HasError() || diags.Errors()[0].Summary() != test.expectedErr {
+					t.Fatalf("expected error %q, got %v", test.expectedErr, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("expected no error, got %v", diags)
+			}
+			if *result.ID != test.expectedId {
+				t.Errorf("expected ID %q, got %q", test.expectedId, *result.ID)
+			}
+		})
+	}
+}
+
+// connectionField matches the connection a list query selects first.
+var connectionField = regexp.MustCompile(`\)\s*\{\s*(\w+)`)
+
+// newLookupServer answers every list query with two items named
+// "Production" in different blueprints and one named "Staging".
+func newLookupServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	connection := map[string]interface{}{
+		"edges": []map[string]interface{}{
+			{"cursor": "item_1", "node": map[string]interface{}{"id": "item_1", "name": "Production", "blueprintId": "blueprint_1"}},
+			{"cursor": "item_2", "node": map[string]interface{}{"id": "item_2", "name": "Production", "blueprintId": "blueprint_2"}},
+			{"cursor": "item_3", "node": map[string]interface{}{"id": "item_3", "name": "Staging"}},
+		},
+		"pageInfo": map[string]interface{}{"hasNextPage": false},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query string `json:"query"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		field := connectionField.FindStringSubmatch(body.Query)[1]
+		data := map[string]interface{}{field: connection}
+		if field == "skillsets" {
+			// Skillset abilities are nested below their skillset
+			data[field] = map[string]interface{}{
+				"edges": []map[string]interface{}{
+					{"node": map[string]interface{}{"id": "skillset_1", "abilities": connection}},
+				},
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// testConfigValues builds a data source configuration that sets the given
+// attributes and leaves every other attribute null.
+func testConfigValues(t *testing.T, d datasource.DataSource, attrs map[string]interface{}) tfsdk.Config {
+	t.Helper()
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+		if value, ok := attrs[name]; ok {
+			values[name] = tftypes.NewValue(attrType, value)
+		}
+	}
+
+	return tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+}
+
+func TestDataSourceLookups(t *testing.T) {
+	server := newLookupServer(t)
+	client := NewClient("test-api-key", server.URL)
+
+	dataSources := map[string]datasource.DataSource{
+		"discord_integration":   &DiscordIntegrationDataSource{client: client},
+		"email_integration":     &EmailIntegrationDataSource{client: client},
+		"extract_integration":   &ExtractIntegrationDataSource{client: client},
+		"file":                  &FileDataSource{client: client},
+		"mcpserver_integration": &McpserverIntegrationDataSource{client: client},
+		"messenger_integration": &MessengerIntegrationDataSource{client: client},
+		"notion_integration":    &NotionIntegrationDataSource{client: client},
+		"portal":                &PortalDataSource{client: client},
+		"secret":                &SecretDataSource{client: client},
+		"sitemap_integration":   &SitemapIntegrationDataSource{client: client},
+		"skillset_ability":      &SkillsetAbilityDataSource{client: client},
+		"slack_integration":     &SlackIntegrationDataSource{client: client},
+		"telegram_integration":  &TelegramIntegrationDataSource{client: client},
+		"trigger_integration":   &TriggerIntegrationDataSource{client: client},
+		"twilio_integration":    &TwilioIntegrationDataSource{client: client},
+		"whats_app_integration": &WhatsAppIntegrationDataSource{client: client},
+	}
+
+	tests := map[string]struct {
+		attrs       map[string]string
+		expectedId  string
+		expectedErr string
+	}{
+		"by id": {
+			attrs:      map[string]string{"id": "item_1"},
+			expectedId: "item_1",
+		},
+		"by unique name": {
+			attrs:      map[string]string{"name": "Staging"},
+			expectedId: "item_3",
+		},
+		"by name scoped to blueprint": {
+			attrs:      map[string]string{"name": "Production", "blueprint_id": "blueprint_2"},
+			expectedId: "item_2",
+		},
+		"ambiguous name": {
+			attrs:       map[string]string{"name": "Production"},
+			expectedErr: "Multiple",
+		},
+		"no match": {
+			attrs:       map[string]string{"name": "Development"},
+			expectedErr: "Not Found",
+		},
+	}
+
+	for dataSourceName, d := range dataSources {
+		for name, test := range tests {
+			t.Run(dataSourceName+"/"+name, func(t *testing.T) {
+				attrs := map[string]interface{}{"skillset_id": "skillset_1"}
+				for k, v := range test.attrs {
+					attrs[k] = v
+				}
+				config := testConfigValues(t, d, attrs)
+
+				resp := &datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema}}
+				d.Read(context.Background(), datasource.ReadRequest{Config: config}, resp)
+
+				if test.expectedErr != "" {
+					if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Summary(), test.expectedErr) {
+						t.Fatalf("expected %q error, got %v", test.expectedErr, resp.Diagnostics)
+					}
+					return
+				}
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("expected no error, got %v", resp.Diagnostics)
+				}
+
+				var id types.String
+				resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("id"), &id)...)
+				if id.ValueString() != test.expectedId {
+					t.Errorf("expected ID %q, got %q", test.expectedId, id.ValueString())
+				}
+			})
+		}
+	}
+}
+
+func TestHasTags(t *testing.T) {
+	tags := []string{"Google", "calendar"}
+
+	if !hasTags(nil, tags) {
+		t.Error("expected no required tags to match")
+	}
+	if !hasTags([]string{"google", "Calendar"}, tags) {
+		t.Error("expected tags to match ignoring case")
+	}
+	if hasTags([]string{"google", "email"}, tags) {
+		t.Error("expected missing tag not to match")
+	}
+}
+
+func TestTimeWindow(t *testing.T) {
+	window, diags := newTimeWindow(types.StringValue("2026-10-01T00:00:00Z"), types.StringValue("2026-10-31T23:59:59Z"))
+	if diags.HasError() {
+		t.Fatalf("expected no error, got %v", diags)
+	}
+
+	tests := map[string]struct {
+		createdAt *string
+		expected  bool
+	}{
+		"inside the window":      {ptr("2026-10-15T12:00:00Z"), true},
+		"on the lower bound":     {ptr("2026-10-01T00:00:00Z"), true},
+		"before the window":      {ptr("2026-09-30T23:59:59Z"), false},
+		"after the window":       {ptr("2026-11-01T00:00:00Z"), false},
+		"with a timezone offset": {ptr("2026-10-01T01:00:00+02:00"), false},
+		"without a timestamp":    {nil, false},
+		"with a bad timestamp":   {ptr("yesterday"), false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := window.contains(test.createdAt); got != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, got)
+			}
+		})
+	}
+
+	t.Run("open window matches everything", func(t *testing.T) {
+		open, _ := newTimeWindow(types.StringNull(), types.StringNull())
+		if !open.contains(nil) {
+			t.Error("expected open window to match")
+		}
+	})
+
+	t.Run("invalid bounds are reported", func(t *testing.T) {
+		if _, diags := newTimeWindow(types.StringValue("2026-10-01"), types.StringNull()); !diags.HasError() {
+			t.Error("expected invalid timestamp error")
+		}
+		if _, diags := newTimeWindow(types.StringValue("2026-10-02T00:00:00Z"), types.StringValue("2026-10-01T00:00:00Z")); !diags.HasError() {
+			t.Error("expected invalid window error")
+		}
+	})
+}
+
+func TestNewestFirst(t *testing.T) {
+	items := []*string{ptr("2026-10-01T00:00:00Z"), ptr("2026-10-03T00:00:00Z"), ptr("2026-10-02T00:00:00Z")}
+	createdAt := func(item *string) *string { return item }
+
+	got := newestFirst(items, createdAt, types.Int64Value(2))
+	if len(got) != 2 || *got[0] != "2026-10-03T00:00:00Z" || *got[1] != "2026-10-02T00:00:00Z" {
+		t.Errorf("expected the two most recent entries, got %v", got)
+	}
+
+	if got := newestFirst(items, createdAt, types.Int64Null()); len(got) != 3 {
+		t.Errorf("expected every entry without a limit, got %d", len(got))
+	}
+}
+
+func TestLogCollector(t *testing.T) {
+	// Entries as the API pages them, most recent first
+	entries := []*string{ptr("2026-10-04T00:00:00Z"), ptr("2026-10-03T00:00:00Z"), ptr("2026-10-02T00:00:00Z"), ptr("2026-10-01T00:00:00Z")}
+	createdAt := func(item *string) *string { return item }
+
+	collect := func(from types.String, limit types.Int64, matches func(*string) bool) (*logCollector[string], int) {
+		window, diags := newTimeWindow(from, types.StringNull())
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		collector := &logCollector[string]{window: window, limit: limit, ordered: true, createdAt: createdAt, matches: matches}
+		visited := 0
+		for _, entry := range entries {
+			visited++
+			if !collector.visit(entry) {
+				break
+			}
+		}
+		return collector, visited
+	}
+	all := func(*string) bool { return true }
+
+	t.Run("stops at the first entry before the window", func(t *testing.T) {
+		collector, visited := collect(types.StringValue("2026-10-03T00:00:00Z"), types.Int64Null(), all)
+		if len(collector.items) != 2 || visited != 3 {
+			t.Errorf("expected 2 entries after visiting 3, got %d after visiting %d", len(collector.items), visited)
+		}
+	})
+
+	t.Run("stops once the limit is reached", func(t *testing.T) {
+		collector, visited := collect(types.StringNull(), types.Int64Value(1), func(item *string) bool { return *item != "2026-10-04T00:00:00Z" })
+		if len(collector.items) != 1 || *collector.items[0] != "2026-10-03T00:00:00Z" || visited != 2 {
+			t.Errorf("expected the first match after visiting 2, got %v after visiting %d", collector.items, visited)
+		}
+	})
+
+	t.Run("visits every entry without a bound", func(t *testing.T) {
+		collector, visited := collect(types.StringNull(), types.Int64Null(), all)
+		if len(collector.items) != 4 || visited != 4 {
+			t.Errorf("expected every entry, got %d after visiting %d", len(collector.items), visited)
+		}
+	})
+
+	t.Run("never stops when the order is unknown", func(t *testing.T) {
+		window, _ := newTimeWindow(types.StringValue("2026-10-03T00:00:00Z"), types.StringNull())
+		collector := &logCollector[string]{window: window, limit: types.Int64Value(1), createdAt: createdAt, matches: all}
+
+		for i := len(entries) - 1; i >= 0; i-- {
+			if !collector.visit(entries[i]) {
+				t.Fatalf("expected paging to continue after %s", *entries[i])
+			}
+		}
+		if len(collector.items) != 2 {
+			t.Errorf("expected the 2 entries in the window, got %v", collector.items)
+		}
+	})
+}
@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PlatformAbilitiesDataSource{}
+
+func NewPlatformAbilitiesDataSource() datasource.DataSource {
+	return &PlatformAbilitiesDataSource{}
+}
+
+// PlatformAbilitiesDataSource defines the data source implementation.
+type PlatformAbilitiesDataSource struct {
+	client *Client
+}
+
+// PlatformAbilitiesDataSourceModel describes the data source data model.
+type PlatformAbilitiesDataSourceModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	ProviderName types.String `tfsdk:"provider_name"`
+	Tags types.List `tfsdk:"tags"`
+	IDs types.List `tfsdk:"ids"`
+	Abilities []PlatformAbilitiesDataSourceAbilityModel `tfsdk:"abilities"`
+}
+
+// PlatformAbilitiesDataSourceAbilityModel describes a catalog ability returned
+// by the data source.
+type PlatformAbilitiesDataSourceAbilityModel struct {
+	ID types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Commentary types.String `tfsdk:"commentary"`
+	Provider types.String `tfsdk:"provider"`
+	Icon types.String `tfsdk:"icon"`
+	Instruction types.String `tfsdk:"instruction"`
+	Schema types.String `tfsdk:"schema"`
+	Secret types.String `tfsdk:"secret"`
+	Setup types.String `tfsdk:"setup"`
+	Space types.String `tfsdk:"space"`
+	File types.String `tfsdk:"file"`
+	Tags types.List `tfsdk:"tags"`
+	Meta types.Map `tfsdk:"meta"`
+}
+
+// Metadata returns the data source type name.
+func (d *PlatformAbilitiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_platform_abilities"
+}
+
+// Schema defines the schema for the data source.
+func (d *PlatformAbilitiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to search the catalog of prebuilt platform abilities by name, provider and tag.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "A regular expression the name of the abilities must match",
+				Optional:            true,
+			},
+			"provider_name": schema.StringAttribute{
+				MarkdownDescription: "Only return abilities from this provider. The comparison is case-insensitive",
+				Optional:            true,
+			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "Only return abilities tagged with all of these tags. The comparison is case-insensitive",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the matching abilities",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"abilities": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching abilities",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the ability",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the ability",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the ability",
+							Computed:            true,
+						},
+						"commentary": schema.StringAttribute{
+							MarkdownDescription: "Additional commentary about the ability",
+							Computed:            true,
+						},
+						"provider": schema.StringAttribute{
+							MarkdownDescription: "The provider of the ability",
+							Computed:            true,
+						},
+						"icon": schema.StringAttribute{
+							MarkdownDescription: "The icon representing the ability",
+							Computed:            true,
+						},
+						"instruction": schema.StringAttribute{
+							MarkdownDescription: "The instruction template of the ability, suitable for `chatbotkit_skillset_ability.instruction`",
+							Computed:            true,
+						},
+						"schema": schema.StringAttribute{
+							MarkdownDescription: "The JSON schema of the ability parameters, encoded as a JSON string",
+							Computed:            true,
+						},
+						"secret": schema.StringAttribute{
+							MarkdownDescription: "The ID of the platform secret the ability requires, if any",
+							Computed:            true,
+						},
+						"setup": schema.StringAttribute{
+							MarkdownDescription: "The setup instructions for the ability",
+							Computed:            true,
+						},
+						"space": schema.StringAttribute{
+							MarkdownDescription: "The space configuration for the ability",
+							Computed:            true,
+						},
+						"file": schema.StringAttribute{
+							MarkdownDescription: "The file configuration for the ability",
+							Computed:            true,
+						},
+						"tags": schema.ListAttribute{
+							MarkdownDescription: "The tags associated with the ability",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"meta": schema.MapAttribute{
+							MarkdownDescription: "Additional metadata for the ability",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *PlatformAbilitiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *PlatformAbilitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PlatformAbilitiesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newListFilter(ctx, data.NameRegex, types.StringNull(), types.StringNull(), types.MapNull(types.StringType))
+	resp.Diagnostics.Append(diags...)
+
+	var tags []string
+	if !data.Tags.IsNull() {
+		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit API to list platform abilities
+	result, err := d.client.ListPlatformAbilities(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list platform abilities: %s", err))
+		return
+	}
+
+	// Update data model with the matching abilities
+
+	ids := []string{}
+	data.Abilities = []PlatformAbilitiesDataSourceAbilityModel{}
+	for _, item := range result {
+		if item == nil || !filter.matches(item.Name, nil, nil, nil) {
+			continue
+		}
+		if !matchesFold(data.ProviderName, item.Provider) || !hasTags(tags, item.Tags) {
+			continue
+		}
+
+		ability, diags := platformAbilityModel(ctx, item)
+		resp.Diagnostics.Append(diags...)
+
+		data.Abilities = append(data.Abilities, ability)
+		if item.ID != nil {
+			ids = append(ids, *item.ID)
+		}
+	}
+
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	data.IDs = idList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// platformAbilityModel converts a catalog ability into its data source model.
+func platformAbilityModel(ctx context.Context, item *PlatformAbilityResponse) (PlatformAbilitiesDataSourceAbilityModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	meta, d := metaValue(ctx, item.Meta)
+	diags.Append(d...)
+
+	tags := types.ListNull(types.StringType)
+	if item.Tags != nil {
+		tags, d = types.ListValueFrom(ctx, types.StringType, item.Tags)
+		diags.Append(d...)
+	}
+
+	parameters, d := jsonStringValue(item.Schema)
+	diags.Append(d...)
+
+	return PlatformAbilitiesDataSourceAbilityModel{
+		ID: types.StringPointerValue(item.ID),
+		Name: types.StringPointerValue(item.Name),
+		Description: types.StringPointerValue(item.Description),
+		Commentary: types.StringPointerValue(item.Commentary),
+		Provider: types.StringPointerValue(item.Provider),
+		Icon: types.StringPointerValue(item.Icon),
+		Instruction: types.StringPointerValue(item.Instruction),
+		Schema: parameters,
+		Secret: types.StringPointerValue(item.Secret),
+		Setup: types.StringPointerValue(item.Setup),
+		Space: types.StringPointerValue(item.Space),
+		File: types.StringPointerValue(item.File),
+		Tags: tags,
+		Meta: meta,
+	}, diags
+}
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PlatformExamplesDataSource{}
+
+func NewPlatformExamplesDataSource() datasource.DataSource {
+	return &PlatformExamplesDataSource{}
+}
+
+// PlatformExamplesDataSource defines the data source implementation.
+type PlatformExamplesDataSource struct {
+	client *Client
+}
+
+// PlatformExamplesDataSourceModel describes the data source data model.
+type PlatformExamplesDataSourceModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	Type types.String `tfsdk:"type"`
+	Tags types.List `tfsdk:"tags"`
+	IncludeConfig types.Bool `tfsdk:"include_config"`
+	IDs types.List `tfsdk:"ids"`
+	Examples []PlatformExamplesDataSourceExampleModel `tfsdk:"examples"`
+}
+
+// PlatformExamplesDataSourceExampleModel describes an example returned by the
+// data source.
+type PlatformExamplesDataSourceExampleModel struct {
+	ID types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Type types.String `tfsdk:"type"`
+	Link types.String `tfsdk:"link"`
+	Tags types.List `tfsdk:"tags"`
+	Config types.String `tfsdk:"config"`
+	Meta types.Map `tfsdk:"meta"`
+}
+
+// Metadata returns the data source type name.
+func (d *PlatformExamplesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_platform_examples"
+}
+
+// Schema defines the schema for the data source.
+func (d *PlatformExamplesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to browse the official platform examples and fetch their full configuration.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "A regular expression the name of the examples must match",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return examples of this type. The comparison is case-insensitive",
+				Optional:            true,
+			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "Only return examples tagged with all of these tags. The comparison is case-insensitive",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"include_config": schema.BoolAttribute{
+				MarkdownDescription: "Whether to fetch the full configuration of every matching example. Each example is fetched with a separate request. Defaults to `false`",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the matching examples",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"examples": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching examples",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the example",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the example",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the example",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the example",
+							Computed:            true,
+						},
+						"link": schema.StringAttribute{
+							MarkdownDescription: "The URL of the example",
+							Computed:            true,
+						},
+						"tags": schema.ListAttribute{
+							MarkdownDescription: "The tags associated with the example",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"config": schema.StringAttribute{
+							MarkdownDescription: "The full configuration of the example encoded as a JSON string. Only set when `include_config` is `true`",
+							Computed:            true,
+						},
+						"meta": schema.MapAttribute{
+							MarkdownDescription: "Additional metadata for the example",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *PlatformExamplesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *PlatformExamplesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PlatformExamplesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newListFilter(ctx, data.NameRegex, types.StringNull(), types.StringNull(), types.MapNull(types.StringType))
+	resp.Diagnostics.Append(diags...)
+
+	var tags []string
+	if !data.Tags.IsNull() {
+		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit API to list platform examples
+	result, err := d.client.ListPlatformExamples(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list platform examples: %s", err))
+		return
+	}
+
+	// Update data model with the matching examples
+
+	ids := []string{}
+	data.Examples = []PlatformExamplesDataSourceExampleModel{}
+	for _, item := range result {
+		if item == nil || !filter.matches(item.Name, nil, nil, nil) {
+			continue
+		}
+		if !matchesFold(data.Type, item.Type) || !hasTags(tags, item.Tags) {
+			continue
+		}
+
+		config := types.StringNull()
+		if data.IncludeConfig.ValueBool() && item.ID != nil {
+			example, err := d.client.FetchPlatformExample(ctx, *item.ID)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch platform example %s: %s", *item.ID, err))
+				return
+			}
+
+			config, diags = jsonStringValue(example.Config)
+			resp.Diagnostics.Append(diags...)
+		}
+
+		meta, diags := metaValue(ctx, item.Meta)
+		resp.Diagnostics.Append(diags...)
+
+		exampleTags := types.ListNull(types.StringType)
+		if item.Tags != nil {
+			exampleTags, diags = types.ListValueFrom(ctx, types.StringType, item.Tags)
+			resp.Diagnostics.Append(diags...)
+		}
+
+		data.Examples = append(data.Examples, PlatformExamplesDataSourceExampleModel{
+			ID: types.StringPointerValue(item.ID),
+			Name: types.StringPointerValue(item.Name),
+			Description: types.StringPointerValue(item.Description),
+			Type: types.StringPointerValue(item.Type),
+			Link: types.StringPointerValue(item.Link),
+			Tags: exampleTags,
+			Config: config,
+			Meta: meta,
+		})
+		if item.ID != nil {
+			ids = append(ids, *item.ID)
+		}
+	}
+
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	data.IDs = idList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
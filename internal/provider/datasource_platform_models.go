@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PlatformModelsDataSource{}
+
+func NewPlatformModelsDataSource() datasource.DataSource {
+	return &PlatformModelsDataSource{}
+}
+
+// PlatformModelsDataSource defines the data source implementation.
+type PlatformModelsDataSource struct {
+	client *Client
+}
+
+// PlatformModelsDataSourceModel describes the data source data model.
+type PlatformModelsDataSourceModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	ProviderName types.String `tfsdk:"provider_name"`
+	Family types.String `tfsdk:"family"`
+	MinTokens types.Int64 `tfsdk:"min_tokens"`
+	MinInputTokens types.Int64 `tfsdk:"min_input_tokens"`
+	MinOutputTokens types.Int64 `tfsdk:"min_output_tokens"`
+	IDs types.List `tfsdk:"ids"`
+	Names types.List `tfsdk:"names"`
+	Models []PlatformModelsDataSourceModelModel `tfsdk:"models"`
+}
+
+// PlatformModelsDataSourceModelModel describes a model returned by the data
+// source.
+type PlatformModelsDataSourceModelModel struct {
+	ID types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Provider types.String `tfsdk:"provider"`
+	Family types.String `tfsdk:"family"`
+	MaxTokens types.Int64 `tfsdk:"max_tokens"`
+	MaxInputTokens types.Int64 `tfsdk:"max_input_tokens"`
+	MaxOutputTokens types.Int64 `tfsdk:"max_output_tokens"`
+	Meta types.Map `tfsdk:"meta"`
+}
+
+// Metadata returns the data source type name.
+func (d *PlatformModelsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_platform_models"
+}
+
+// Schema defines the schema for the data source.
+func (d *PlatformModelsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list the models available on the platform, optionally filtered by provider, family and context size. Matching models are ordered by `max_input_tokens`, largest first.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "A regular expression the name of the models must match",
+				Optional:            true,
+			},
+			"provider_name": schema.StringAttribute{
+				MarkdownDescription: "Only return models from this provider, e.g. `anthropic` or `openai`. The comparison is case-insensitive",
+				Optional:            true,
+			},
+			"family": schema.StringAttribute{
+				MarkdownDescription: "Only return models of this family. The comparison is case-insensitive",
+				Optional:            true,
+			},
+			"min_tokens": schema.Int64Attribute{
+				MarkdownDescription: "Only return models whose total context size is at least this many tokens",
+				Optional:            true,
+			},
+			"min_input_tokens": schema.Int64Attribute{
+				MarkdownDescription: "Only return models that accept at least this many input tokens",
+				Optional:            true,
+			},
+			"min_output_tokens": schema.Int64Attribute{
+				MarkdownDescription: "Only return models that can produce at least this many output tokens",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the matching models",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"names": schema.ListAttribute{
+				MarkdownDescription: "The names of the matching models, suitable for `chatbotkit_bot.model`",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"models": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching models",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the model",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the model",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the model",
+							Computed:            true,
+						},
+						"provider": schema.StringAttribute{
+							MarkdownDescription: "The provider of the model",
+							Computed:            true,
+						},
+						"family": schema.StringAttribute{
+							MarkdownDescription: "The family of the model",
+							Computed:            true,
+						},
+						"max_tokens": schema.Int64Attribute{
+							MarkdownDescription: "The maximum number of tokens for the model",
+							Computed:            true,
+						},
+						"max_input_tokens": schema.Int64Attribute{
+							MarkdownDescription: "The maximum number of input tokens for the model",
+							Computed:            true,
+						},
+						"max_output_tokens": schema.Int64Attribute{
+							MarkdownDescription: "The maximum number of output tokens for the model",
+							Computed:            true,
+						},
+						"meta": schema.MapAttribute{
+							MarkdownDescription: "Additional metadata for the model",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *PlatformModelsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *PlatformModelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PlatformModelsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newListFilter(ctx, data.NameRegex, types.StringNull(), types.StringNull(), types.MapNull(types.StringType))
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit API to list platform models
+	result, err := d.client.ListPlatformModels(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list platform models: %s", err))
+		return
+	}
+
+	// Update data model with the matching models
+
+	models := filterPlatformModels(result, filter, data)
+
+	ids := []string{}
+	names := []string{}
+	data.Models = []PlatformModelsDataSourceModelModel{}
+	for _, item := range models {
+		meta, diags := metaValue(ctx, item.Meta)
+		resp.Diagnostics.Append(diags...)
+
+		data.Models = append(data.Models, PlatformModelsDataSourceModelModel{
+			ID: types.StringPointerValue(item.ID),
+			Name: types.StringPointerValue(item.Name),
+			Description: types.StringPointerValue(item.Description),
+			Provider: types.StringPointerValue(item.Provider),
+			Family: types.StringPointerValue(item.Family),
+			MaxTokens: types.Int64PointerValue(item.MaxTokens),
+			MaxInputTokens: types.Int64PointerValue(item.MaxInputTokens),
+			MaxOutputTokens: types.Int64PointerValue(item.MaxOutputTokens),
+			Meta: meta,
+		})
+		if item.ID != nil {
+			ids = append(ids, *item.ID)
+		}
+		if item.Name != nil {
+			names = append(names, *item.Name)
+		}
+	}
+
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	data.IDs = idList
+
+	nameList, diags := types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	data.Names = nameList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filterPlatformModels returns the models matching the configured filters,
+// ordered by input context size (largest first) and then by name.
+func filterPlatformModels(models []*PlatformModelResponse, filter *listFilter, data PlatformModelsDataSourceModel) []*PlatformModelResponse {
+	var result []*PlatformModelResponse
+	for _, item := range models {
+		if item == nil || !filter.matches(item.Name, nil, nil, nil) {
+			continue
+		}
+		if !matchesFold(data.ProviderName, item.Provider) || !matchesFold(data.Family, item.Family) {
+			continue
+		}
+		if !atLeast(data.MinTokens, item.MaxTokens) || !atLeast(data.MinInputTokens, item.MaxInputTokens) || !atLeast(data.MinOutputTokens, item.MaxOutputTokens) {
+			continue
+		}
+		result = append(result, item)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		a, b := int64Value(result[i].MaxInputTokens), int64Value(result[j].MaxInputTokens)
+		if a != b {
+			return a > b
+		}
+		return stringValue(result[i].Name) < stringValue(result[j].Name)
+	})
+
+	return result
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFilterPlatformModels(t *testing.T) {
+	ctx := context.Background()
+
+	models := []*PlatformModelResponse{
+		{ID: ptr("gpt-4o"), Name: ptr("gpt-4o"), Provider: ptr("openai"), Family: ptr("gpt-4"), MaxInputTokens: int64Ptr(128000)},
+		{ID: ptr("claude-4.5-haiku"), Name: ptr("claude-4.5-haiku"), Provider: ptr("anthropic"), Family: ptr("claude-4.5"), MaxInputTokens: int64Ptr(200000)},
+		{ID: ptr("claude-4.5-sonnet"), Name: ptr("claude-4.5-sonnet"), Provider: ptr("anthropic"), Family: ptr("claude-4.5"), MaxInputTokens: int64Ptr(1000000)},
+		{ID: ptr("claude-3-haiku"), Name: ptr("claude-3-haiku"), Provider: ptr("anthropic"), Family: ptr("claude-3"), MaxInputTokens: int64Ptr(100000)},
+		nil,
+	}
+
+	names := func(result []*PlatformModelResponse) []string {
+		var out []string
+		for _, item := range result {
+			out = append(out, *item.Name)
+		}
+		return out
+	}
+
+	empty, _ := newListFilter(ctx, types.StringNull(), types.StringNull(), types.StringNull(), types.MapNull(types.StringType))
+
+	t.Run("orders by input tokens", func(t *testing.T) {
+		got := names(filterPlatformModels(models, empty, PlatformModelsDataSourceModel{}))
+		expected := []string{"claude-4.5-sonnet", "claude-4.5-haiku", "gpt-4o", "claude-3-haiku"}
+		if len(got) != len(expected) {
+			t.Fatalf("expected %v, got %v", expected, got)
+		}
+		for i := range expected {
+			if got[i] != expected[i] {
+				t.Fatalf("expected %v, got %v", expected, got)
+			}
+		}
+	})
+
+	t.Run("filters provider and minimum input tokens", func(t *testing.T) {
+		got := names(filterPlatformModels(models, empty, PlatformModelsDataSourceModel{
+			ProviderName:   types.StringValue("Anthropic"),
+			MinInputTokens: types.Int64Value(200000),
+		}))
+		if len(got) != 2 || got[0] != "claude-4.5-sonnet" || got[1] != "claude-4.5-haiku" {
+			t.Errorf("expected the two large anthropic models, got %v", got)
+		}
+	})
+
+	t.Run("filters family and name", func(t *testing.T) {
+		filter, _ := newListFilter(ctx, types.StringValue("haiku$"), types.StringNull(), types.StringNull(), types.MapNull(types.StringType))
+		got := names(filterPlatformModels(models, filter, PlatformModelsDataSourceModel{
+			Family: types.StringValue("claude-3"),
+		}))
+		if len(got) != 1 || got[0] != "claude-3-haiku" {
+			t.Errorf("expected claude-3-haiku, got %v", got)
+		}
+	})
+
+	t.Run("models without limits fail minimums", func(t *testing.T) {
+		got := filterPlatformModels(models, empty, PlatformModelsDataSourceModel{
+			MinOutputTokens: types.Int64Value(1),
+		})
+		if len(got) != 0 {
+			t.Errorf("expected no models, got %v", names(got))
+		}
+	})
+}
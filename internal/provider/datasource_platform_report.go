@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PlatformReportDataSource{}
+
+func NewPlatformReportDataSource() datasource.DataSource {
+	return &PlatformReportDataSource{}
+}
+
+// PlatformReportDataSource defines the data source implementation.
+type PlatformReportDataSource struct {
+	client *Client
+}
+
+// PlatformReportDataSourceModel describes the data source data model.
+type PlatformReportDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	ReportId types.String `tfsdk:"report_id"`
+	Parameters types.Map `tfsdk:"parameters"`
+	Name types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Report types.String `tfsdk:"report"`
+	Numbers types.Map `tfsdk:"numbers"`
+	Strings types.Map `tfsdk:"strings"`
+	Bools types.Map `tfsdk:"bools"`
+}
+
+// Metadata returns the data source type name.
+func (d *PlatformReportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_platform_report"
+}
+
+// Schema defines the schema for the data source.
+func (d *PlatformReportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to generate a platform usage or analytics report.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the report",
+				Computed:            true,
+			},
+
+			"report_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the report to generate",
+				Required:            true,
+			},
+			"parameters": schema.MapAttribute{
+				MarkdownDescription: "The report parameters, such as the time range or the bot to report on",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the report",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the report",
+				Computed:            true,
+			},
+			"report": schema.StringAttribute{
+				MarkdownDescription: "The report data encoded as a JSON string",
+				Computed:            true,
+			},
+			"numbers": schema.MapAttribute{
+				MarkdownDescription: "The numeric values of the report, keyed by their dotted path",
+				Computed:            true,
+				ElementType:         types.Float64Type,
+			},
+			"strings": schema.MapAttribute{
+				MarkdownDescription: "The string values of the report, keyed by their dotted path",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"bools": schema.MapAttribute{
+				MarkdownDescription: "The boolean values of the report, keyed by their dotted path",
+				Computed:            true,
+				ElementType:         types.BoolType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *PlatformReportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *PlatformReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PlatformReportDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit API to generate the report
+	result, err := d.client.FetchPlatformReport(ctx, data.ReportId.ValueString(), convertMapToInterface(ctx, data.Parameters))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch platform report: %s", err))
+		return
+	}
+
+	// Update data model with response values
+
+	data.ID = data.ReportId
+	if result.ID != nil {
+		data.ID = types.StringPointerValue(result.ID)
+	}
+	data.Name = types.StringPointerValue(result.Name)
+	data.Description = types.StringPointerValue(result.Description)
+
+	report, diags := jsonStringValue(result.Report)
+	resp.Diagnostics.Append(diags...)
+	data.Report = report
+
+	numbers, strs, bools := flattenReport(result.Report)
+
+	data.Numbers, diags = types.MapValueFrom(ctx, types.Float64Type, numbers)
+	resp.Diagnostics.Append(diags...)
+	data.Strings, diags = types.MapValueFrom(ctx, types.StringType, strs)
+	resp.Diagnostics.Append(diags...)
+	data.Bools, diags = types.MapValueFrom(ctx, types.BoolType, bools)
+	resp.Diagnostics.Append(diags...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// flattenReport flattens report data into maps of numbers, strings and bools
+// keyed by the dotted path of each value. List elements are addressed by their
+// index, for example `bots.0.conversations`. Null values are skipped.
+func flattenReport(report map[string]interface{}) (map[string]float64, map[string]string, map[string]bool) {
+	numbers := map[string]float64{}
+	strs := map[string]string{}
+	bools := map[string]bool{}
+
+	var walk func(prefix string, value interface{})
+	walk = func(prefix string, value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			for key, item := range v {
+				walk(joinReportPath(prefix, key), item)
+			}
+		case []interface{}:
+			for i, item := range v {
+				walk(joinReportPath(prefix, strconv.Itoa(i)), item)
+			}
+		case float64:
+			numbers[prefix] = v
+		case string:
+			strs[prefix] = v
+		case bool:
+			bools[prefix] = v
+		}
+	}
+	walk("", report)
+
+	return numbers, strs, bools
+}
+
+// joinReportPath appends a key to a dotted report path.
+func joinReportPath(prefix string, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFlattenReport(t *testing.T) {
+	var report map[string]interface{}
+	if err := json.Unmarshal([]byte(`{
+		"period": "2026-09",
+		"total": {"tokens": 125000, "conversations": 42},
+		"bots": [{"id": "bot_1", "tokens": 100000, "overBudget": true}],
+		"empty": null
+	}`), &report); err != nil {
+		t.Fatal(err)
+	}
+
+	numbers, strs, bools := flattenReport(report)
+
+	if numbers["total.tokens"] != 125000 || numbers["total.conversations"] != 42 || numbers["bots.0.tokens"] != 100000 {
+		t.Errorf("unexpected numbers %v", numbers)
+	}
+	if strs["period"] != "2026-09" || strs["bots.0.id"] != "bot_1" {
+		t.Errorf("unexpected strings %v", strs)
+	}
+	if !bools["bots.0.overBudget"] || len(bools) != 1 {
+		t.Errorf("unexpected bools %v", bools)
+	}
+	if _, ok := strs["empty"]; ok {
+		t.Error("expected null values to be skipped")
+	}
+}
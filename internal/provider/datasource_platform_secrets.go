@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PlatformSecretsDataSource{}
+
+func NewPlatformSecretsDataSource() datasource.DataSource {
+	return &PlatformSecretsDataSource{}
+}
+
+// PlatformSecretsDataSource defines the data source implementation.
+type PlatformSecretsDataSource struct {
+	client *Client
+}
+
+// PlatformSecretsDataSourceModel describes the data source data model.
+type PlatformSecretsDataSourceModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	Tags types.List `tfsdk:"tags"`
+	IDs types.List `tfsdk:"ids"`
+	Secrets []PlatformSecretsDataSourceSecretModel `tfsdk:"secrets"`
+}
+
+// PlatformSecretsDataSourceSecretModel describes a secret template returned by
+// the data source.
+type PlatformSecretsDataSourceSecretModel struct {
+	ID types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Commentary types.String `tfsdk:"commentary"`
+	Icon types.String `tfsdk:"icon"`
+	Kind types.String `tfsdk:"kind"`
+	Type types.String `tfsdk:"type"`
+	Config types.Map `tfsdk:"config"`
+	Setup types.String `tfsdk:"setup"`
+	Tags types.List `tfsdk:"tags"`
+	Meta types.Map `tfsdk:"meta"`
+}
+
+// Metadata returns the data source type name.
+func (d *PlatformSecretsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_platform_secrets"
+}
+
+// Schema defines the schema for the data source.
+func (d *PlatformSecretsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list the predefined secret templates that platform abilities depend on.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "A regular expression the name of the secret templates must match",
+				Optional:            true,
+			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "Only return secret templates tagged with all of these tags. The comparison is case-insensitive",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the matching secret templates",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"secrets": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching secret templates",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the secret template",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the secret template",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the secret template",
+							Computed:            true,
+						},
+						"commentary": schema.StringAttribute{
+							MarkdownDescription: "Additional commentary about the secret template",
+							Computed:            true,
+						},
+						"icon": schema.StringAttribute{
+							MarkdownDescription: "The icon representing the secret template",
+							Computed:            true,
+						},
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "The kind of secret the template describes",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of secret the template describes",
+							Computed:            true,
+						},
+						"config": schema.MapAttribute{
+							MarkdownDescription: "The configuration of the secret, suitable for `chatbotkit_secret.config`",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"setup": schema.StringAttribute{
+							MarkdownDescription: "The setup instructions for the secret",
+							Computed:            true,
+						},
+						"tags": schema.ListAttribute{
+							MarkdownDescription: "The tags associated with the secret template",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"meta": schema.MapAttribute{
+							MarkdownDescription: "Additional metadata for the secret template",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *PlatformSecretsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *PlatformSecretsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PlatformSecretsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newListFilter(ctx, data.NameRegex, types.StringNull(), types.StringNull(), types.MapNull(types.StringType))
+	resp.Diagnostics.Append(diags...)
+
+	var tags []string
+	if !data.Tags.IsNull() {
+		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit API to list platform secrets
+	result, err := d.client.ListPlatformSecrets(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list platform secrets: %s", err))
+		return
+	}
+
+	// Update data model with the matching secret templates
+
+	ids := []string{}
+	data.Secrets = []PlatformSecretsDataSourceSecretModel{}
+	for _, item := range result {
+		if item == nil || !filter.matches(item.Name, nil, nil, nil) || !hasTags(tags, item.Tags) {
+			continue
+		}
+
+		secret, diags := platformSecretModel(ctx, item)
+		resp.Diagnostics.Append(diags...)
+
+		data.Secrets = append(data.Secrets, secret)
+		if item.ID != nil {
+			ids = append(ids, *item.ID)
+		}
+	}
+
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	data.IDs = idList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// platformSecretModel converts a secret template into its data source model.
+func platformSecretModel(ctx context.Context, item *PlatformSecretResponse) (PlatformSecretsDataSourceSecretModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	meta, d := metaValue(ctx, item.Meta)
+	diags.Append(d...)
+
+	config, d := configValue(ctx, item.Config)
+	diags.Append(d...)
+
+	tags := types.ListNull(types.StringType)
+	if item.Tags != nil {
+		tags, d = types.ListValueFrom(ctx, types.StringType, item.Tags)
+		diags.Append(d...)
+	}
+
+	return PlatformSecretsDataSourceSecretModel{
+		ID: types.StringPointerValue(item.ID),
+		Name: types.StringPointerValue(item.Name),
+		Description: types.StringPointerValue(item.Description),
+		Commentary: types.StringPointerValue(item.Commentary),
+		Icon: types.StringPointerValue(item.Icon),
+		Kind: types.StringPointerValue(item.Kind),
+		Type: types.StringPointerValue(item.Type),
+		Config: config,
+		Setup: types.StringPointerValue(item.Setup),
+		Tags: tags,
+		Meta: meta,
+	}, diags
+}
+
+// configValue converts a secret configuration into a map of strings. String
+// values are kept as they are and other values are encoded as JSON, so that
+// the map can be passed to chatbotkit_secret.config.
+func configValue(ctx context.Context, config map[string]interface{}) (types.Map, diag.Diagnostics) {
+	if config == nil {
+		return types.MapNull(types.StringType), nil
+	}
+
+	values := make(map[string]string, len(config))
+	for key, value := range config {
+		if s, ok := value.(string); ok {
+			values[key] = s
+			continue
+		}
+
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			var diags diag.Diagnostics
+			diags.AddError("Encoding Error", fmt.Sprintf("Unable to encode secret config %q as JSON: %s", key, err))
+			return types.MapNull(types.StringType), diags
+		}
+		values[key] = string(encoded)
+	}
+
+	return types.MapValueFrom(ctx, types.StringType, values)
+}
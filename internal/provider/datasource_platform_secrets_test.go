@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestConfigValue(t *testing.T) {
+	ctx := context.Background()
+
+	value, diags := configValue(ctx, map[string]interface{}{
+		"authUrl": "https://accounts.example.com/authorize",
+		"scopes":  []interface{}{"calendar.read", "calendar.write"},
+		"pkce":    true,
+	})
+	if diags.HasError() {
+		t.Fatalf("expected no error, got %v", diags)
+	}
+
+	var config map[string]string
+	value.ElementsAs(ctx, &config, false)
+
+	expected := map[string]string{
+		"authUrl": "https://accounts.example.com/authorize",
+		"scopes":  `["calendar.read","calendar.write"]`,
+		"pkce":    "true",
+	}
+	for key, want := range expected {
+		if config[key] != want {
+			t.Errorf("expected %s to be %q, got %q", key, want, config[key])
+		}
+	}
+
+	if null, _ := configValue(ctx, nil); !null.IsNull() {
+		t.Error("expected null config for missing config")
+	}
+}
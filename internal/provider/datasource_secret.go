@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SecretDataSource{}
+
+func NewSecretDataSource() datasource.DataSource {
+	return &SecretDataSource{}
+}
+
+// SecretDataSource defines the data source implementation.
+type SecretDataSource struct {
+	client *Client
+}
+
+// SecretDataSourceModel describes the data source data model.
+type SecretDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	Description types.String `tfsdk:"description"`
+	Kind types.String `tfsdk:"kind"`
+	Meta types.Map `tfsdk:"meta"`
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+	Visibility types.String `tfsdk:"visibility"`
+	VerificationStatus types.String `tfsdk:"verification_status"`
+	VerificationUrl types.String `tfsdk:"verification_url"`
+	Contacts types.List `tfsdk:"contacts"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *SecretDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret"
+}
+
+// Schema defines the schema for the data source.
+func (d *SecretDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get information about an existing secret.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the secret to look up. Conflicts with `name`",
+			},
+
+			"blueprint_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the blueprint the secret belongs to. When set, the lookup only considers objects in this blueprint",
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the secret",
+				Computed:            true,
+			},
+			"kind": schema.StringAttribute{
+				MarkdownDescription: "The kind of secret (personal or organizational)",
+				Computed:            true,
+			},
+			"meta": schema.MapAttribute{
+				MarkdownDescription: "Additional metadata for the secret",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the secret to look up. Conflicts with `id`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of secret (token or other)",
+				Computed:            true,
+			},
+			"visibility": schema.StringAttribute{
+				MarkdownDescription: "The visibility level of the secret",
+				Computed:            true,
+			},
+			"verification_status": schema.StringAttribute{
+				MarkdownDescription: "The verification status of the secret, for example `authenticated` or `unauthenticated`",
+				Computed:            true,
+			},
+			"verification_url": schema.StringAttribute{
+				MarkdownDescription: "The URL to visit to authenticate the secret. Null when the secret is authenticated or needs no authentication",
+				Computed:            true,
+			},
+			"contacts": schema.ListNestedAttribute{
+				MarkdownDescription: "The contacts that authenticated the secret. Only personal secrets have contacts",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the contact",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the contact",
+							Computed:            true,
+						},
+						"nick": schema.StringAttribute{
+							MarkdownDescription: "The nickname of the contact",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The email address of the contact",
+							Computed:            true,
+						},
+						"phone": schema.StringAttribute{
+							MarkdownDescription: "The phone number of the contact",
+							Computed:            true,
+						},
+						"verification_status": schema.StringAttribute{
+							MarkdownDescription: "The verification status of the secret for the contact",
+							Computed:            true,
+						},
+						"verification_url": schema.StringAttribute{
+							MarkdownDescription: "The URL the contact has to visit to authenticate the secret",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was created",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SecretDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *SecretDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SecretDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit GraphQL API to look up secret by ID or name
+	items, err := d.client.ListSecrets(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secret: %s", err))
+		return
+	}
+
+	result, diags := lookupOne(items, "secret", data.ID, data.Name, data.BlueprintId, func(item *GetSecretResponse) (*string, *string, *string) {
+		return item.ID, item.Name, item.BlueprintId
+	})
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringPointerValue(result.ID)
+
+	// Update data model with response values
+
+	if result.BlueprintId != nil {
+		data.BlueprintId = types.StringPointerValue(result.BlueprintId)
+	}
+	if result.Description != nil {
+		data.Description = types.StringPointerValue(result.Description)
+	}
+	if result.Kind != nil {
+		data.Kind = types.StringPointerValue(result.Kind)
+	}
+	if result.Meta != nil {
+		mapValue, diags := types.MapValueFrom(ctx, types.StringType, result.Meta)
+		resp.Diagnostics.Append(diags...)
+		data.Meta = mapValue
+	}
+	if result.Name != nil {
+		data.Name = types.StringPointerValue(result.Name)
+	}
+	if result.Type != nil {
+		data.Type = types.StringPointerValue(result.Type)
+	}
+	if result.Visibility != nil {
+		data.Visibility = types.StringPointerValue(result.Visibility)
+	}
+	if result.CreatedAt != nil {
+		data.CreatedAt = types.StringPointerValue(result.CreatedAt)
+	}
+	if result.UpdatedAt != nil {
+		data.UpdatedAt = types.StringPointerValue(result.UpdatedAt)
+	}
+	data.VerificationStatus = types.StringPointerValue(secretVerificationStatus(result.Verification))
+	data.VerificationUrl = types.StringPointerValue(secretVerificationURL(result))
+
+	contacts, diags := secretContactsValue(ctx, result.Contacts)
+	resp.Diagnostics.Append(diags...)
+	data.Contacts = contacts
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func dataSourceSchema(d datasource.DataSource) schema.Schema {
+	var resp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+func TestSecretDataSourceSchema(t *testing.T) {
+	attributes := dataSourceSchema(&SecretDataSource{}).Attributes
+
+	for _, name := range []string{"value", "config"} {
+		if _, ok := attributes[name]; ok {
+			t.Errorf("expected the secret data source not to expose '%s'", name)
+		}
+	}
+}
+
+func TestDataSourceTokensSensitive(t *testing.T) {
+	tests := map[string]struct {
+		dataSource datasource.DataSource
+		attributes []string
+	}{
+		"discord_integration":   {&DiscordIntegrationDataSource{}, []string{"bot_token"}},
+		"messenger_integration": {&MessengerIntegrationDataSource{}, []string{"access_token"}},
+		"notion_integration":    {&NotionIntegrationDataSource{}, []string{"token"}},
+		"slack_integration":     {&SlackIntegrationDataSource{}, []string{"bot_token", "signing_secret", "user_token"}},
+		"telegram_integration":  {&TelegramIntegrationDataSource{}, []string{"bot_token"}},
+		"whats_app_integration": {&WhatsAppIntegrationDataSource{}, []string{"access_token"}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			attributes := dataSourceSchema(test.dataSource).Attributes
+			for _, attribute := range test.attributes {
+				if a, ok := attributes[attribute]; !ok || !a.IsSensitive() {
+					t.Errorf("expected '%s' to be sensitive", attribute)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SitemapIntegrationDataSource{}
+
+func NewSitemapIntegrationDataSource() datasource.DataSource {
+	return &SitemapIntegrationDataSource{}
+}
+
+// SitemapIntegrationDataSource defines the data source implementation.
+type SitemapIntegrationDataSource struct {
+	client *Client
+}
+
+// SitemapIntegrationDataSourceModel describes the data source data model.
+type SitemapIntegrationDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	DatasetId types.String `tfsdk:"dataset_id"`
+	Description types.String `tfsdk:"description"`
+	ExpiresIn types.Int64 `tfsdk:"expires_in"`
+	Glob types.String `tfsdk:"glob"`
+	Javascript types.Bool `tfsdk:"javascript"`
+	Meta types.Map `tfsdk:"meta"`
+	Name types.String `tfsdk:"name"`
+	Selectors types.String `tfsdk:"selectors"`
+	SyncSchedule types.String `tfsdk:"sync_schedule"`
+	URL types.String `tfsdk:"url"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *SitemapIntegrationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sitemap_integration"
+}
+
+// Schema defines the schema for the data source.
+func (d *SitemapIntegrationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get information about an existing sitemap integration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the sitemap integration to look up. Conflicts with `name`",
+			},
+
+			"blueprint_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the blueprint the sitemap integration belongs to. When set, the lookup only considers objects in this blueprint",
+				Optional:            true,
+				Computed:            true,
+			},
+			"dataset_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the dataset to sync to",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the integration",
+				Computed:            true,
+			},
+			"expires_in": schema.Int64Attribute{
+				MarkdownDescription: "Time in milliseconds before the data expires",
+				Computed:            true,
+			},
+			"glob": schema.StringAttribute{
+				MarkdownDescription: "Glob pattern to filter URLs",
+				Computed:            true,
+			},
+			"javascript": schema.BoolAttribute{
+				MarkdownDescription: "Whether to enable JavaScript rendering",
+				Computed:            true,
+			},
+			"meta": schema.MapAttribute{
+				MarkdownDescription: "Additional metadata for the integration",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the sitemap integration to look up. Conflicts with `id`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"selectors": schema.StringAttribute{
+				MarkdownDescription: "CSS selectors to focus on specific parts of the pages",
+				Computed:            true,
+			},
+			"sync_schedule": schema.StringAttribute{
+				MarkdownDescription: "The schedule for automatic synchronization",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the sitemap to crawl",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was created",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SitemapIntegrationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *SitemapIntegrationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SitemapIntegrationDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit GraphQL API to look up sitemap integration by ID or name
+	items, err := d.client.ListSitemapIntegrations(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sitemap integration: %s", err))
+		return
+	}
+
+	result, diags := lookupOne(items, "sitemap integration", data.ID, data.Name, data.BlueprintId, func(item *GetSitemapIntegrationResponse) (*string, *string, *string) {
+		return item.ID, item.Name, item.BlueprintId
+	})
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringPointerValue(result.ID)
+
+	// Update data model with response values
+
+	if result.BlueprintId != nil {
+		data.BlueprintId = types.StringPointerValue(result.BlueprintId)
+	}
+	if result.DatasetId != nil {
+		data.DatasetId = types.StringPointerValue(result.DatasetId)
+	}
+	if result.Description != nil {
+		data.Description = types.StringPointerValue(result.Description)
+	}
+	if result.ExpiresIn != nil {
+		data.ExpiresIn = types.Int64PointerValue(result.ExpiresIn)
+	}
+	if result.Glob != nil {
+		data.Glob = types.StringPointerValue(result.Glob)
+	}
+	if result.Javascript != nil {
+		data.Javascript = types.BoolPointerValue(result.Javascript)
+	}
+	if result.Meta != nil {
+		mapValue, diags := types.MapValueFrom(ctx, types.StringType, result.Meta)
+		resp.Diagnostics.Append(diags...)
+		data.Meta = mapValue
+	}
+	if result.Name != nil {
+		data.Name = types.StringPointerValue(result.Name)
+	}
+	if result.Selectors != nil {
+		data.Selectors = types.StringPointerValue(result.Selectors)
+	}
+	if result.SyncSchedule != nil {
+		data.SyncSchedule = types.StringPointerValue(result.SyncSchedule)
+	}
+	if result.URL != nil {
+		data.URL = types.StringPointerValue(result.URL)
+	}
+	if result.CreatedAt != nil {
+		data.CreatedAt = types.StringPointerValue(result.CreatedAt)
+	}
+	if result.UpdatedAt != nil {
+		data.UpdatedAt = types.StringPointerValue(result.UpdatedAt)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
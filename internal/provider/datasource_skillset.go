@@ -45,12 +45,14 @@ func (d *SkillsetDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 		MarkdownDescription: "Use this data source to get information about an existing skillset.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The unique identifier of the skillset to look up",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the skillset to look up. Conflicts with `name`",
 			},
 
 			"blueprint_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the blueprint to use",
+				MarkdownDescription: "The ID of the blueprint the skillset belongs to. When set, the lookup only considers skillsets in this blueprint",
+				Optional:            true,
 				Computed:            true,
 			},
 			"description": schema.StringAttribute{
@@ -63,7 +65,8 @@ func (d *SkillsetDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				ElementType:         types.StringType,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the skillset",
+				MarkdownDescription: "The name of the skillset to look up. Conflicts with `id`",
+				Optional:            true,
 				Computed:            true,
 			},
 			"visibility": schema.StringAttribute{
@@ -111,13 +114,24 @@ func (d *SkillsetDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	// Call the ChatBotKit GraphQL API to read skillset
-	result, err := d.client.GetSkillset(ctx, data.ID.ValueString())
+	// Call the ChatBotKit GraphQL API to look up skillset by ID or name
+	items, err := d.client.ListSkillsets(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read skillset: %s", err))
 		return
 	}
 
+	result, diags := lookupOne(items, "skillset", data.ID, data.Name, data.BlueprintId, func(item *GetSkillsetResponse) (*string, *string, *string) {
+		return item.ID, item.Name, item.BlueprintId
+	})
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringPointerValue(result.ID)
+
 	// Update data model with response values
 
 	if result.BlueprintId != nil {
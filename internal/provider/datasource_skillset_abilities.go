@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SkillsetAbilitiesDataSource{}
+
+func NewSkillsetAbilitiesDataSource() datasource.DataSource {
+	return &SkillsetAbilitiesDataSource{}
+}
+
+// SkillsetAbilitiesDataSource defines the data source implementation.
+type SkillsetAbilitiesDataSource struct {
+	client *Client
+}
+
+// SkillsetAbilitiesDataSourceModel describes the data source data model.
+type SkillsetAbilitiesDataSourceModel struct {
+	SkillsetId types.String `tfsdk:"skillset_id"`
+	NameRegex types.String `tfsdk:"name_regex"`
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	Meta types.Map `tfsdk:"meta"`
+	IDs types.List `tfsdk:"ids"`
+	Abilities []SkillsetAbilitiesDataSourceAbilityModel `tfsdk:"abilities"`
+}
+
+// SkillsetAbilitiesDataSourceAbilityModel describes an ability returned by the
+// data source.
+type SkillsetAbilitiesDataSourceAbilityModel struct {
+	ID types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Instruction types.String `tfsdk:"instruction"`
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	BotId types.String `tfsdk:"bot_id"`
+	FileId types.String `tfsdk:"file_id"`
+	SecretId types.String `tfsdk:"secret_id"`
+	SpaceId types.String `tfsdk:"space_id"`
+	Meta types.Map `tfsdk:"meta"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *SkillsetAbilitiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_skillset_abilities"
+}
+
+// Schema defines the schema for the data source.
+func (d *SkillsetAbilitiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := listFilterAttributes("abilities", true)
+
+	// Abilities have no visibility of their own
+	delete(attributes, "visibility")
+
+	attributes["skillset_id"] = schema.StringAttribute{
+		MarkdownDescription: "The ID of the skillset to list the abilities of",
+		Required:            true,
+	}
+	attributes["ids"] = schema.ListAttribute{
+		MarkdownDescription: "The IDs of the matching abilities",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["abilities"] = schema.ListNestedAttribute{
+		MarkdownDescription: "The matching abilities",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+							"id": schema.StringAttribute{
+								MarkdownDescription: "The unique identifier of the ability",
+								Computed:            true,
+							},
+							"name": schema.StringAttribute{
+								MarkdownDescription: "The name of the ability",
+								Computed:            true,
+							},
+							"description": schema.StringAttribute{
+								MarkdownDescription: "The description of the ability",
+								Computed:            true,
+							},
+							"instruction": schema.StringAttribute{
+								MarkdownDescription: "The instruction for the ability",
+								Computed:            true,
+							},
+							"blueprint_id": schema.StringAttribute{
+								MarkdownDescription: "The ID of the blueprint the ability belongs to",
+								Computed:            true,
+							},
+							"bot_id": schema.StringAttribute{
+								MarkdownDescription: "The ID of the bot the ability uses",
+								Computed:            true,
+							},
+							"file_id": schema.StringAttribute{
+								MarkdownDescription: "The ID of the file the ability uses",
+								Computed:            true,
+							},
+							"secret_id": schema.StringAttribute{
+								MarkdownDescription: "The ID of the secret the ability uses for authentication",
+								Computed:            true,
+							},
+							"space_id": schema.StringAttribute{
+								MarkdownDescription: "The ID of the space the ability uses",
+								Computed:            true,
+							},
+							"meta": schema.MapAttribute{
+								MarkdownDescription: "Additional metadata for the ability",
+								Computed:            true,
+								ElementType:         types.StringType,
+							},
+							"created_at": schema.StringAttribute{
+								MarkdownDescription: "Timestamp when the ability was created",
+								Computed:            true,
+							},
+							"updated_at": schema.StringAttribute{
+								MarkdownDescription: "Timestamp when the ability was last updated",
+								Computed:            true,
+							},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list the abilities of a skillset, optionally filtered.",
+		Attributes:          attributes,
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SkillsetAbilitiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *SkillsetAbilitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SkillsetAbilitiesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newListFilter(ctx, data.NameRegex, types.StringNull(), data.BlueprintId, data.Meta)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit GraphQL API to list the skillset abilities
+	result, err := d.client.ListSkillsetAbilities(ctx, data.SkillsetId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list skillset abilities: %s", err))
+		return
+	}
+
+	// Update data model with the matching abilities
+
+	ids := []string{}
+	data.Abilities = []SkillsetAbilitiesDataSourceAbilityModel{}
+	for _, item := range result {
+		if item == nil || !filter.matches(item.Name, nil, item.BlueprintId, item.Meta) {
+			continue
+		}
+
+		meta, diags := metaValue(ctx, item.Meta)
+		resp.Diagnostics.Append(diags...)
+
+		data.Abilities = append(data.Abilities, SkillsetAbilitiesDataSourceAbilityModel{
+			ID: types.StringPointerValue(item.ID),
+			Name: types.StringPointerValue(item.Name),
+			Description: types.StringPointerValue(item.Description),
+			Instruction: types.StringPointerValue(item.Instruction),
+			BlueprintId: types.StringPointerValue(item.BlueprintId),
+			BotId: types.StringPointerValue(item.BotId),
+			FileId: types.StringPointerValue(item.FileId),
+			SecretId: types.StringPointerValue(item.SecretId),
+			SpaceId: types.StringPointerValue(item.SpaceId),
+			Meta: meta,
+			CreatedAt: types.StringPointerValue(item.CreatedAt),
+			UpdatedAt: types.StringPointerValue(item.UpdatedAt),
+		})
+		if item.ID != nil {
+			ids = append(ids, *item.ID)
+		}
+	}
+
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	data.IDs = idList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
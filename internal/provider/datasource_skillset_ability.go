@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SkillsetAbilityDataSource{}
+
+func NewSkillsetAbilityDataSource() datasource.DataSource {
+	return &SkillsetAbilityDataSource{}
+}
+
+// SkillsetAbilityDataSource defines the data source implementation.
+type SkillsetAbilityDataSource struct {
+	client *Client
+}
+
+// SkillsetAbilityDataSourceModel describes the data source data model.
+type SkillsetAbilityDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	SkillsetId types.String `tfsdk:"skillset_id"`
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	BotId types.String `tfsdk:"bot_id"`
+	Description types.String `tfsdk:"description"`
+	FileId types.String `tfsdk:"file_id"`
+	Instruction types.String `tfsdk:"instruction"`
+	Meta types.Map `tfsdk:"meta"`
+	Name types.String `tfsdk:"name"`
+	SecretId types.String `tfsdk:"secret_id"`
+	SpaceId types.String `tfsdk:"space_id"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *SkillsetAbilityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_skillset_ability"
+}
+
+// Schema defines the schema for the data source.
+func (d *SkillsetAbilityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get information about an existing skillset ability.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the skillset ability to look up. Conflicts with `name`",
+			},
+
+			"skillset_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the skillset the ability belongs to",
+				Required:            true,
+			},
+			"blueprint_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the blueprint the skillset ability belongs to. When set, the lookup only considers objects in this blueprint",
+				Optional:            true,
+				Computed:            true,
+			},
+			"bot_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the bot to use",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the ability",
+				Computed:            true,
+			},
+			"file_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the file to use",
+				Computed:            true,
+			},
+			"instruction": schema.StringAttribute{
+				MarkdownDescription: "The instruction for the ability",
+				Computed:            true,
+			},
+			"meta": schema.MapAttribute{
+				MarkdownDescription: "Additional metadata for the ability",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the skillset ability to look up. Conflicts with `id`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"secret_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the secret to use for authentication",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the space to use",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was created",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SkillsetAbilityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *SkillsetAbilityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SkillsetAbilityDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit GraphQL API to look up skillset ability by ID or name
+	items, err := d.client.ListSkillsetAbilities(ctx, data.SkillsetId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read skillset ability: %s", err))
+		return
+	}
+
+	result, diags := lookupOne(items, "skillset ability", data.ID, data.Name, data.BlueprintId, func(item *GetSkillsetAbilityResponse) (*string, *string, *string) {
+		return item.ID, item.Name, item.BlueprintId
+	})
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringPointerValue(result.ID)
+
+	// Update data model with response values
+
+	if result.BlueprintId != nil {
+		data.BlueprintId = types.StringPointerValue(result.BlueprintId)
+	}
+	if result.BotId != nil {
+		data.BotId = types.StringPointerValue(result.BotId)
+	}
+	if result.Description != nil {
+		data.Description = types.StringPointerValue(result.Description)
+	}
+	if result.FileId != nil {
+		data.FileId = types.StringPointerValue(result.FileId)
+	}
+	if result.Instruction != nil {
+		data.Instruction = types.StringPointerValue(result.Instruction)
+	}
+	if result.Meta != nil {
+		mapValue, diags := types.MapValueFrom(ctx, types.StringType, result.Meta)
+		resp.Diagnostics.Append(diags...)
+		data.Meta = mapValue
+	}
+	if result.Name != nil {
+		data.Name = types.StringPointerValue(result.Name)
+	}
+	if result.SecretId != nil {
+		data.SecretId = types.StringPointerValue(result.SecretId)
+	}
+	if result.SpaceId != nil {
+		data.SpaceId = types.StringPointerValue(result.SpaceId)
+	}
+	if result.CreatedAt != nil {
+		data.CreatedAt = types.StringPointerValue(result.CreatedAt)
+	}
+	if result.UpdatedAt != nil {
+		data.UpdatedAt = types.StringPointerValue(result.UpdatedAt)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SkillsetsDataSource{}
+
+func NewSkillsetsDataSource() datasource.DataSource {
+	return &SkillsetsDataSource{}
+}
+
+// SkillsetsDataSource defines the data source implementation.
+type SkillsetsDataSource struct {
+	client *Client
+}
+
+// SkillsetsDataSourceModel describes the data source data model.
+type SkillsetsDataSourceModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	Visibility types.String `tfsdk:"visibility"`
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	Meta types.Map `tfsdk:"meta"`
+	IDs types.List `tfsdk:"ids"`
+	Skillsets []SkillsetsDataSourceSkillsetModel `tfsdk:"skillsets"`
+}
+
+// SkillsetsDataSourceSkillsetModel describes a skillset returned by the data source.
+type SkillsetsDataSourceSkillsetModel struct {
+	ID types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	Visibility types.String `tfsdk:"visibility"`
+	Meta types.Map `tfsdk:"meta"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *SkillsetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_skillsets"
+}
+
+// Schema defines the schema for the data source.
+func (d *SkillsetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := listFilterAttributes("skillsets", true)
+
+	attributes["ids"] = schema.ListAttribute{
+		MarkdownDescription: "The IDs of the matching skillsets",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["skillsets"] = schema.ListNestedAttribute{
+		MarkdownDescription: "The matching skillsets",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+							"id": schema.StringAttribute{
+								MarkdownDescription: "The unique identifier of the skillset",
+								Computed:            true,
+							},
+							"name": schema.StringAttribute{
+								MarkdownDescription: "The name of the skillset",
+								Computed:            true,
+							},
+							"description": schema.StringAttribute{
+								MarkdownDescription: "The description of the skillset",
+								Computed:            true,
+							},
+							"blueprint_id": schema.StringAttribute{
+								MarkdownDescription: "The ID of the blueprint the skillset belongs to",
+								Computed:            true,
+							},
+							"visibility": schema.StringAttribute{
+								MarkdownDescription: "The visibility level of the skillset",
+								Computed:            true,
+							},
+							"meta": schema.MapAttribute{
+								MarkdownDescription: "Additional metadata for the skillset",
+								Computed:            true,
+								ElementType:         types.StringType,
+							},
+							"created_at": schema.StringAttribute{
+								MarkdownDescription: "Timestamp when the skillset was created",
+								Computed:            true,
+							},
+							"updated_at": schema.StringAttribute{
+								MarkdownDescription: "Timestamp when the skillset was last updated",
+								Computed:            true,
+							},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list existing skillsets, optionally filtered.",
+		Attributes:          attributes,
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SkillsetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *SkillsetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SkillsetsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newListFilter(ctx, data.NameRegex, data.Visibility, data.BlueprintId, data.Meta)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit GraphQL API to list skillsets
+	result, err := d.client.ListSkillsets(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list skillsets: %s", err))
+		return
+	}
+
+	// Update data model with the matching skillsets
+
+	ids := []string{}
+	data.Skillsets = []SkillsetsDataSourceSkillsetModel{}
+	for _, item := range result {
+		if item == nil || !filter.matches(item.Name, item.Visibility, item.BlueprintId, item.Meta) {
+			continue
+		}
+
+		meta, diags := metaValue(ctx, item.Meta)
+		resp.Diagnostics.Append(diags...)
+
+		data.Skillsets = append(data.Skillsets, SkillsetsDataSourceSkillsetModel{
+			ID: types.StringPointerValue(item.ID),
+			Name: types.StringPointerValue(item.Name),
+			Description: types.StringPointerValue(item.Description),
+			BlueprintId: types.StringPointerValue(item.BlueprintId),
+			Visibility: types.StringPointerValue(item.Visibility),
+			CreatedAt: types.StringPointerValue(item.CreatedAt),
+			UpdatedAt: types.StringPointerValue(item.UpdatedAt),
+			Meta: meta,
+		})
+		if item.ID != nil {
+			ids = append(ids, *item.ID)
+		}
+	}
+
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	data.IDs = idList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
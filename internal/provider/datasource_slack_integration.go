@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SlackIntegrationDataSource{}
+
+func NewSlackIntegrationDataSource() datasource.DataSource {
+	return &SlackIntegrationDataSource{}
+}
+
+// SlackIntegrationDataSource defines the data source implementation.
+type SlackIntegrationDataSource struct {
+	client *Client
+}
+
+// SlackIntegrationDataSourceModel describes the data source data model.
+type SlackIntegrationDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	AutoRespond types.String `tfsdk:"auto_respond"`
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	BotId types.String `tfsdk:"bot_id"`
+	BotToken types.String `tfsdk:"bot_token"`
+	ContactCollection types.Bool `tfsdk:"contact_collection"`
+	Description types.String `tfsdk:"description"`
+	Meta types.Map `tfsdk:"meta"`
+	Name types.String `tfsdk:"name"`
+	Ratings types.Bool `tfsdk:"ratings"`
+	References types.Bool `tfsdk:"references"`
+	SessionDuration types.Int64 `tfsdk:"session_duration"`
+	SigningSecret types.String `tfsdk:"signing_secret"`
+	UserToken types.String `tfsdk:"user_token"`
+	VisibleMessages types.Int64 `tfsdk:"visible_messages"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *SlackIntegrationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_slack_integration"
+}
+
+// Schema defines the schema for the data source.
+func (d *SlackIntegrationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get information about an existing slack integration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the slack integration to look up. Conflicts with `name`",
+			},
+
+			"auto_respond": schema.StringAttribute{
+				MarkdownDescription: "Auto-respond configuration for the integration",
+				Computed:            true,
+			},
+			"blueprint_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the blueprint the slack integration belongs to. When set, the lookup only considers objects in this blueprint",
+				Optional:            true,
+				Computed:            true,
+			},
+			"bot_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the bot to connect",
+				Computed:            true,
+			},
+			"bot_token": schema.StringAttribute{
+				MarkdownDescription: "The Slack bot token for API access",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"contact_collection": schema.BoolAttribute{
+				MarkdownDescription: "Whether to collect contact information",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the integration",
+				Computed:            true,
+			},
+			"meta": schema.MapAttribute{
+				MarkdownDescription: "Additional metadata for the integration",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the slack integration to look up. Conflicts with `id`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ratings": schema.BoolAttribute{
+				MarkdownDescription: "Whether to enable message ratings",
+				Computed:            true,
+			},
+			"references": schema.BoolAttribute{
+				MarkdownDescription: "Whether to include message references",
+				Computed:            true,
+			},
+			"session_duration": schema.Int64Attribute{
+				MarkdownDescription: "The duration of the session in milliseconds",
+				Computed:            true,
+			},
+			"signing_secret": schema.StringAttribute{
+				MarkdownDescription: "The Slack signing secret for request verification",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"user_token": schema.StringAttribute{
+				MarkdownDescription: "The Slack user token for additional permissions",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"visible_messages": schema.Int64Attribute{
+				MarkdownDescription: "The number of visible messages in the conversation",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was created",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SlackIntegrationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *SlackIntegrationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SlackIntegrationDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit GraphQL API to look up slack integration by ID or name
+	items, err := d.client.ListSlackIntegrations(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read slack integration: %s", err))
+		return
+	}
+
+	result, diags := lookupOne(items, "slack integration", data.ID, data.Name, data.BlueprintId, func(item *GetSlackIntegrationResponse) (*string, *string, *string) {
+		return item.ID, item.Name, item.BlueprintId
+	})
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringPointerValue(result.ID)
+
+	// Update data model with response values
+
+	if result.AutoRespond != nil {
+		data.AutoRespond = types.StringPointerValue(result.AutoRespond)
+	}
+	if result.BlueprintId != nil {
+		data.BlueprintId = types.StringPointerValue(result.BlueprintId)
+	}
+	if result.BotId != nil {
+		data.BotId = types.StringPointerValue(result.BotId)
+	}
+	if result.BotToken != nil {
+		data.BotToken = types.StringPointerValue(result.BotToken)
+	}
+	if result.ContactCollection != nil {
+		data.ContactCollection = types.BoolPointerValue(result.ContactCollection)
+	}
+	if result.Description != nil {
+		data.Description = types.StringPointerValue(result.Description)
+	}
+	if result.Meta != nil {
+		mapValue, diags := types.MapValueFrom(ctx, types.StringType, result.Meta)
+		resp.Diagnostics.Append(diags...)
+		data.Meta = mapValue
+	}
+	if result.Name != nil {
+		data.Name = types.StringPointerValue(result.Name)
+	}
+	if result.Ratings != nil {
+		data.Ratings = types.BoolPointerValue(result.Ratings)
+	}
+	if result.References != nil {
+		data.References = types.BoolPointerValue(result.References)
+	}
+	if result.SessionDuration != nil {
+		data.SessionDuration = types.Int64PointerValue(result.SessionDuration)
+	}
+	if result.SigningSecret != nil {
+		data.SigningSecret = types.StringPointerValue(result.SigningSecret)
+	}
+	if result.UserToken != nil {
+		data.UserToken = types.StringPointerValue(result.UserToken)
+	}
+	if result.VisibleMessages != nil {
+		data.VisibleMessages = types.Int64PointerValue(result.VisibleMessages)
+	}
+	if result.CreatedAt != nil {
+		data.CreatedAt = types.StringPointerValue(result.CreatedAt)
+	}
+	if result.UpdatedAt != nil {
+		data.UpdatedAt = types.StringPointerValue(result.UpdatedAt)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSpaceDataSourceRead(t *testing.T) {
+	server, _ := newRESTServer(t, func(r *http.Request) (int, interface{}) {
+		if r.URL.Path != "/v1/space/space_123/fetch" {
+			return http.StatusNotFound, map[string]interface{}{"message": "Not Found"}
+		}
+		return http.StatusOK, map[string]interface{}{
+			"id":          "space_123",
+			"name":        "Support",
+			"blueprintId": "blueprint_123",
+			"createdAt":   "2025-01-01T00:00:00Z",
+		}
+	})
+
+	d := &SpaceDataSource{client: NewClient("test-api-key", server.URL)}
+	ctx := context.Background()
+
+	read := func(id string) *datasource.ReadResponse {
+		config := testDataSourceConfig(t, d, &SpaceDataSourceModel{
+			ID:   types.StringValue(id),
+			Meta: types.MapNull(types.StringType),
+		})
+		resp := &datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema}}
+		d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+		return resp
+	}
+
+	t.Run("fills the space attributes", func(t *testing.T) {
+		resp := read("space_123")
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("expected no error, got %v", resp.Diagnostics)
+		}
+
+		var got SpaceDataSourceModel
+		resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+		if got.Name.ValueString() != "Support" || got.BlueprintId.ValueString() != "blueprint_123" {
+			t.Errorf("expected name and blueprint from the API, got %v", got)
+		}
+		if !got.ContactId.IsNull() {
+			t.Errorf("expected contact_id to stay null, got %v", got.ContactId)
+		}
+	})
+
+	t.Run("fails for an unknown space", func(t *testing.T) {
+		resp := read("space_missing")
+		if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "not found") {
+			t.Errorf("expected a not found error, got %v", resp.Diagnostics)
+		}
+	})
+}
@@ -85,20 +85,28 @@ func (p *ChatBotKitProvider) Resources(ctx context.Context) []func() resource.Re
 
 		NewBlueprintResource,
 		NewBotResource,
+		NewContactResource,
+		NewConversationResource,
 		NewDatasetResource,
+		NewDatasetFileResource,
 		NewDiscordIntegrationResource,
 		NewEmailIntegrationResource,
 		NewExtractIntegrationResource,
 		NewFileResource,
 		NewMcpserverIntegrationResource,
+		NewMemoryResource,
 		NewMessengerIntegrationResource,
 		NewNotionIntegrationResource,
+		NewPlatformExampleCloneResource,
 		NewPortalResource,
 		NewSecretResource,
 		NewSitemapIntegrationResource,
 		NewSkillsetAbilityResource,
+		NewSkillsetPlatformAbilityResource,
 		NewSkillsetResource,
 		NewSlackIntegrationResource,
+		NewSpaceResource,
+		NewTaskResource,
 		NewTelegramIntegrationResource,
 		NewTriggerIntegrationResource,
 		NewTwilioIntegrationResource,
@@ -109,10 +117,43 @@ func (p *ChatBotKitProvider) Resources(ctx context.Context) []func() resource.Re
 func (p *ChatBotKitProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 
+		NewAuditLogsDataSource,
 		NewBlueprintDataSource,
+		NewBlueprintsDataSource,
+		NewBlueprintContentsDataSource,
 		NewBotDataSource,
+		NewBotsDataSource,
+		NewContactDataSource,
+		NewConversationsDataSource,
 		NewDatasetDataSource,
+		NewDatasetsDataSource,
+		NewDatasetSearchDataSource,
+		NewDiscordIntegrationDataSource,
+		NewEmailIntegrationDataSource,
+		NewEventLogsDataSource,
+		NewExtractIntegrationDataSource,
+		NewFileDataSource,
+		NewMcpserverIntegrationDataSource,
+		NewMessengerIntegrationDataSource,
+		NewNotionIntegrationDataSource,
+		NewPlatformAbilitiesDataSource,
+		NewPlatformExamplesDataSource,
+		NewPlatformModelsDataSource,
+		NewPlatformReportDataSource,
+		NewPlatformSecretsDataSource,
+		NewPortalDataSource,
+		NewSecretDataSource,
+		NewSitemapIntegrationDataSource,
 		NewSkillsetDataSource,
+		NewSkillsetAbilityDataSource,
+		NewSkillsetAbilitiesDataSource,
+		NewSkillsetsDataSource,
+		NewSlackIntegrationDataSource,
+		NewSpaceDataSource,
+		NewTelegramIntegrationDataSource,
+		NewTriggerIntegrationDataSource,
+		NewTwilioIntegrationDataSource,
+		NewWhatsAppIntegrationDataSource,
 	}
 }
 
@@ -1,10 +1,14 @@
 package provider
 
 import (
+	"context"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -33,3 +37,35 @@ func TestProviderSchema(t *testing.T) {
 		}
 	})
 }
+
+// testResourceState builds a state of the resource schema holding model, for
+// calling resource methods directly. The raw value doubles as a plan.
+func testResourceState(t *testing.T, r resource.Resource, model interface{}) tfsdk.State {
+	t.Helper()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(context.Background(), model); diags.HasError() {
+		t.Fatalf("unable to build state: %v", diags)
+	}
+
+	return state
+}
+
+// testDataSourceConfig builds a configuration of the data source schema
+// holding model, for calling Read directly.
+func testDataSourceConfig(t *testing.T, d datasource.DataSource, model interface{}) tfsdk.Config {
+	t.Helper()
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(context.Background(), model); diags.HasError() {
+		t.Fatalf("unable to build config: %v", diags)
+	}
+
+	return tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw}
+}
@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ContactResource{}
+	_ resource.ResourceWithImportState = &ContactResource{}
+)
+
+func NewContactResource() resource.Resource {
+	return &ContactResource{}
+}
+
+// ContactResource defines the resource implementation.
+type ContactResource struct {
+	client *Client
+}
+
+// ContactResourceModel describes the resource data model.
+type ContactResourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	Description types.String `tfsdk:"description"`
+	Email types.String `tfsdk:"email"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+	Meta types.Map `tfsdk:"meta"`
+	Name types.String `tfsdk:"name"`
+	Nick types.String `tfsdk:"nick"`
+	Phone types.String `tfsdk:"phone"`
+	VerifiedAt types.String `tfsdk:"verified_at"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *ContactResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_contact"
+}
+
+// Schema defines the schema for the resource.
+func (r *ContactResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Input parameters for creating a new contact",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the contact",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the contact",
+				Optional:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email of the contact",
+				Optional:            true,
+			},
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: "The fingerprint of the contact",
+				Optional:            true,
+			},
+			"meta": schema.MapAttribute{
+				MarkdownDescription: "Additional metadata for the contact",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the contact",
+				Optional:            true,
+			},
+			"nick": schema.StringAttribute{
+				MarkdownDescription: "The nickname of the contact",
+				Optional:            true,
+			},
+			"phone": schema.StringAttribute{
+				MarkdownDescription: "The phone number of the contact",
+				Optional:            true,
+			},
+			"verified_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the contact was verified",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was created",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ContactResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ContactResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ContactResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit GraphQL API to create contact
+
+	result, err := r.client.CreateContact(ctx, CreateContactInput{
+		Description: data.Description.ValueStringPointer(),
+		Email: data.Email.ValueStringPointer(),
+		Fingerprint: data.Fingerprint.ValueStringPointer(),
+		Meta: convertMapToInterface(ctx, data.Meta),
+		Name: data.Name.ValueStringPointer(),
+		Nick: data.Nick.ValueStringPointer(),
+		Phone: data.Phone.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create contact: %s", err))
+		return
+	}
+
+	// Set the ID from the response
+	if result.ID != nil {
+		data.ID = types.StringPointerValue(result.ID)
+	}
+
+	nullUnknownStrings(&data.VerifiedAt, &data.CreatedAt, &data.UpdatedAt)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ContactResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ContactResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit GraphQL API to read contact
+
+	result, err := r.client.GetContact(ctx, data.ID.ValueString())
+	if err != nil {
+		// Check if resource was deleted outside of Terraform
+		if strings.Contains(err.Error(), "not found") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read contact: %s", err))
+		return
+	}
+
+	// Update data model with response values
+
+	if result.Description != nil {
+		data.Description = types.StringPointerValue(result.Description)
+	}
+	if result.Email != nil {
+		data.Email = types.StringPointerValue(result.Email)
+	}
+	if result.Fingerprint != nil {
+		data.Fingerprint = types.StringPointerValue(result.Fingerprint)
+	}
+	if result.Meta != nil {
+		mapValue, diags := types.MapValueFrom(ctx, types.StringType, result.Meta)
+		resp.Diagnostics.Append(diags...)
+		data.Meta = mapValue
+	}
+	if result.Name != nil {
+		data.Name = types.StringPointerValue(result.Name)
+	}
+	if result.Nick != nil {
+		data.Nick = types.StringPointerValue(result.Nick)
+	}
+	if result.Phone != nil {
+		data.Phone = types.StringPointerValue(result.Phone)
+	}
+	data.VerifiedAt = types.StringPointerValue(result.VerifiedAt)
+	if result.CreatedAt != nil {
+		data.CreatedAt = types.StringPointerValue(result.CreatedAt)
+	}
+	if result.UpdatedAt != nil {
+		data.UpdatedAt = types.StringPointerValue(result.UpdatedAt)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ContactResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ContactResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit GraphQL API to update contact
+
+	_, err := r.client.UpdateContact(ctx, data.ID.ValueString(), UpdateContactInput{
+		Description: data.Description.ValueStringPointer(),
+		Email: data.Email.ValueStringPointer(),
+		Fingerprint: data.Fingerprint.ValueStringPointer(),
+		Meta: convertMapToInterface(ctx, data.Meta),
+		Name: data.Name.ValueStringPointer(),
+		Nick: data.Nick.ValueStringPointer(),
+		Phone: data.Phone.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update contact: %s", err))
+		return
+	}
+
+	nullUnknownStrings(&data.VerifiedAt, &data.CreatedAt, &data.UpdatedAt)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ContactResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ContactResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit GraphQL API to delete contact
+
+	_, err := r.client.DeleteContact(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete contact: %s", err))
+		return
+	}
+}
+
+// ImportState imports the resource state from Terraform.
+func (r *ContactResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
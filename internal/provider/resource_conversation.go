@@ -0,0 +1,476 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ConversationResource{}
+	_ resource.ResourceWithImportState = &ConversationResource{}
+)
+
+// messageTypes lists the types a conversation message can have.
+var messageTypes = []string{
+	"user",
+	"bot",
+	"context",
+	"instruction",
+	"backstory",
+	"activity",
+	"reasoning",
+}
+
+func NewConversationResource() resource.Resource {
+	return &ConversationResource{}
+}
+
+// ConversationResource defines the resource implementation.
+type ConversationResource struct {
+	client *Client
+}
+
+// ConversationResourceModel describes the resource data model.
+type ConversationResourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	BotId types.String `tfsdk:"bot_id"`
+	ContactId types.String `tfsdk:"contact_id"`
+	Description types.String `tfsdk:"description"`
+	Meta types.Map `tfsdk:"meta"`
+	Name types.String `tfsdk:"name"`
+	SpaceId types.String `tfsdk:"space_id"`
+	Message []ConversationMessageModel `tfsdk:"message"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// ConversationMessageModel describes a message block of a conversation.
+type ConversationMessageModel struct {
+	ID types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+	Text types.String `tfsdk:"text"`
+}
+
+// Metadata returns the resource type name.
+func (r *ConversationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_conversation"
+}
+
+// Schema defines the schema for the resource.
+func (r *ConversationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Input parameters for creating a new conversation",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the conversation",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"bot_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the bot the conversation is with",
+				Required:            true,
+			},
+			"contact_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the contact the conversation is with",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the conversation",
+				Optional:            true,
+			},
+			"meta": schema.MapAttribute{
+				MarkdownDescription: "Additional metadata for the conversation",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the conversation",
+				Optional:            true,
+			},
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the space the conversation takes place in",
+				Optional:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was created",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was last updated",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"message": schema.ListNestedBlock{
+				MarkdownDescription: "The messages of the conversation, in order",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the message",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the message",
+							Required:            true,
+							Validators: []validator.String{
+								stringOneOf(messageTypes...),
+							},
+						},
+						"text": schema.StringAttribute{
+							MarkdownDescription: "The text of the message",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ConversationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ConversationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ConversationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit API to create conversation
+
+	result, err := r.client.CreateConversation(ctx, CreateConversationInput{
+		BotId: data.BotId.ValueStringPointer(),
+		ContactId: data.ContactId.ValueStringPointer(),
+		Description: data.Description.ValueStringPointer(),
+		Meta: convertMapToInterface(ctx, data.Meta),
+		Name: data.Name.ValueStringPointer(),
+		SpaceId: data.SpaceId.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create conversation: %s", err))
+		return
+	}
+
+	// Set the ID from the response
+	if result.ID != nil {
+		data.ID = types.StringPointerValue(result.ID)
+	}
+
+	nullUnknownStrings(&data.CreatedAt, &data.UpdatedAt)
+
+	// Messages are added one by one so that each message ID is known
+	messages, err := syncConversationMessages(ctx, r.client, data.ID.ValueString(), nil, data.Message)
+	data.Message = messages
+
+	// Save data into Terraform state, including the messages created so far
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add conversation messages: %s", err))
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ConversationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ConversationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit API to read conversation
+
+	result, err := r.client.GetConversation(ctx, data.ID.ValueString())
+	if err != nil {
+		// Check if resource was deleted outside of Terraform
+		if strings.Contains(err.Error(), "not found") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read conversation: %s", err))
+		return
+	}
+
+	messages, err := r.client.ListConversationMessages(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read conversation messages: %s", err))
+		return
+	}
+
+	// Update data model with response values
+
+	if result.BotId != nil {
+		data.BotId = types.StringPointerValue(result.BotId)
+	}
+	if result.ContactId != nil {
+		data.ContactId = types.StringPointerValue(result.ContactId)
+	}
+	if result.Description != nil {
+		data.Description = types.StringPointerValue(result.Description)
+	}
+	if result.Meta != nil {
+		mapValue, diags := types.MapValueFrom(ctx, types.StringType, result.Meta)
+		resp.Diagnostics.Append(diags...)
+		data.Meta = mapValue
+	}
+	if result.Name != nil {
+		data.Name = types.StringPointerValue(result.Name)
+	}
+	if result.SpaceId != nil {
+		data.SpaceId = types.StringPointerValue(result.SpaceId)
+	}
+	if result.CreatedAt != nil {
+		data.CreatedAt = types.StringPointerValue(result.CreatedAt)
+	}
+	if result.UpdatedAt != nil {
+		data.UpdatedAt = types.StringPointerValue(result.UpdatedAt)
+	}
+
+	data.Message = make([]ConversationMessageModel, 0, len(messages))
+	for _, message := range messages {
+		if message == nil {
+			continue
+		}
+		data.Message = append(data.Message, ConversationMessageModel{
+			ID:   types.StringPointerValue(message.ID),
+			Type: types.StringPointerValue(message.Type),
+			Text: types.StringPointerValue(message.Text),
+		})
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ConversationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ConversationResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit API to update conversation
+
+	_, err := r.client.UpdateConversation(ctx, data.ID.ValueString(), UpdateConversationInput{
+		BotId: data.BotId.ValueStringPointer(),
+		ContactId: data.ContactId.ValueStringPointer(),
+		Description: data.Description.ValueStringPointer(),
+		Meta: convertMapToInterface(ctx, data.Meta),
+		Name: data.Name.ValueStringPointer(),
+		SpaceId: data.SpaceId.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update conversation: %s", err))
+		return
+	}
+
+	nullUnknownStrings(&data.CreatedAt, &data.UpdatedAt)
+
+	messages, err := syncConversationMessages(ctx, r.client, data.ID.ValueString(), state.Message, data.Message)
+	data.Message = messages
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update conversation messages: %s", err))
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ConversationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ConversationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit API to delete conversation, which also deletes
+	// its messages
+
+	_, err := r.client.DeleteConversation(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete conversation: %s", err))
+		return
+	}
+}
+
+// ImportState imports the resource state from Terraform.
+func (r *ConversationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// syncConversationMessages brings the messages of a conversation from the
+// current list to the desired list with as few API calls as possible. New
+// messages can only be appended to a conversation, so the sync keeps an
+// ordered subset of the current messages for the leading desired messages,
+// updating those whose type or text differ, deletes the rest and appends the
+// remaining desired messages. The subset is chosen by planConversationSync
+// to minimise the number of calls. It returns the messages that exist after
+// the sync, which on error is the state reached so far.
+func syncConversationMessages(ctx context.Context, client *Client, conversationId string, current []ConversationMessageModel, desired []ConversationMessageModel) ([]ConversationMessageModel, error) {
+	keep := planConversationSync(current, desired)
+
+	kept := make([]ConversationMessageModel, 0, len(desired))
+	for i := range current {
+		if keep[i] {
+			kept = append(kept, current[i])
+			continue
+		}
+		if _, err := client.DeleteConversationMessage(ctx, conversationId, current[i].ID.ValueString()); err != nil {
+			return append(kept, current[i:]...), err
+		}
+	}
+
+	result := make([]ConversationMessageModel, 0, len(desired))
+	for i, message := range kept {
+		if !sameConversationMessage(message, desired[i]) {
+			_, err := client.UpdateConversationMessage(ctx, conversationId, message.ID.ValueString(), ConversationMessageInput{
+				Type: desired[i].Type.ValueStringPointer(),
+				Text: desired[i].Text.ValueStringPointer(),
+			})
+			if err != nil {
+				return append(result, kept[i:]...), err
+			}
+		}
+
+		result = append(result, ConversationMessageModel{
+			ID:   message.ID,
+			Type: desired[i].Type,
+			Text: desired[i].Text,
+		})
+	}
+
+	for i := len(kept); i < len(desired); i++ {
+		created, err := client.CreateConversationMessage(ctx, conversationId, ConversationMessageInput{
+			Type: desired[i].Type.ValueStringPointer(),
+			Text: desired[i].Text.ValueStringPointer(),
+		})
+		if err != nil {
+			return result, err
+		}
+
+		result = append(result, ConversationMessageModel{
+			ID:   types.StringPointerValue(created.ID),
+			Type: desired[i].Type,
+			Text: desired[i].Text,
+		})
+	}
+
+	return result, nil
+}
+
+// planConversationSync reports which of the current messages to keep. The
+// kept messages, in order, become the first desired messages and the other
+// desired messages are appended after them. The plan minimises the number of
+// deletes, updates and creates with an edit distance over the type and text
+// of the messages in which insertions are only possible at the end. Keeping
+// a message is preferred over replacing it when both cost the same.
+func planConversationSync(current []ConversationMessageModel, desired []ConversationMessageModel) []bool {
+	n, m := len(current), len(desired)
+
+	// cost[i][j] is the fewest calls that turn the first i current messages
+	// into the first j desired messages without creating any, or -1 when
+	// that is not possible because j exceeds i
+	cost := make([][]int, n+1)
+	for i := range cost {
+		cost[i] = make([]int, m+1)
+		for j := range cost[i] {
+			cost[i][j] = -1
+		}
+		cost[i][0] = i
+	}
+	for i := 1; i <= n; i++ {
+		for j := 1; j <= m && j <= i; j++ {
+			best := cost[i-1][j-1] + conversationUpdateCost(current[i-1], desired[j-1])
+			if cost[i-1][j] >= 0 && cost[i-1][j]+1 < best {
+				best = cost[i-1][j] + 1
+			}
+			cost[i][j] = best
+		}
+	}
+
+	// Pick how many desired messages are covered by kept messages, with the
+	// rest created
+	kept := 0
+	for j := 1; j <= m && j <= n; j++ {
+		if cost[n][j]+m-j <= cost[n][kept]+m-kept {
+			kept = j
+		}
+	}
+
+	keep := make([]bool, n)
+	for i, j := n, kept; i > 0; i-- {
+		if j > 0 && cost[i][j] == cost[i-1][j-1]+conversationUpdateCost(current[i-1], desired[j-1]) {
+			keep[i-1] = true
+			j--
+		}
+	}
+
+	return keep
+}
+
+// conversationUpdateCost returns the number of calls needed to turn one
+// message into another in place.
+func conversationUpdateCost(current ConversationMessageModel, desired ConversationMessageModel) int {
+	if sameConversationMessage(current, desired) {
+		return 0
+	}
+	return 1
+}
+
+// sameConversationMessage reports whether two messages have the same type and
+// text.
+func sameConversationMessage(a ConversationMessageModel, b ConversationMessageModel) bool {
+	return a.Type.Equal(b.Type) && a.Text.Equal(b.Text)
+}
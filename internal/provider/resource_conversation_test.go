@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSyncConversationMessages(t *testing.T) {
+	message := func(id, messageType, text string) ConversationMessageModel {
+		m := ConversationMessageModel{
+			ID:   types.StringUnknown(),
+			Type: types.StringValue(messageType),
+			Text: types.StringValue(text),
+		}
+		if id != "" {
+			m.ID = types.StringValue(id)
+		}
+		return m
+	}
+
+	tests := map[string]struct {
+		current  []ConversationMessageModel
+		desired  []ConversationMessageModel
+		calls    []string
+		expected []string
+	}{
+		"unchanged messages make no calls": {
+			current:  []ConversationMessageModel{message("m1", "user", "hi"), message("m2", "bot", "hello")},
+			desired:  []ConversationMessageModel{message("", "user", "hi"), message("", "bot", "hello")},
+			calls:    nil,
+			expected: []string{"m1", "m2"},
+		},
+		"changed message is updated in place": {
+			current:  []ConversationMessageModel{message("m1", "user", "hi"), message("m2", "bot", "hello")},
+			desired:  []ConversationMessageModel{message("", "user", "hi"), message("", "bot", "hello there")},
+			calls:    []string{"/v1/conversation/conv_1/message/m2/update"},
+			expected: []string{"m1", "m2"},
+		},
+		"appended message is created": {
+			current:  []ConversationMessageModel{message("m1", "user", "hi")},
+			desired:  []ConversationMessageModel{message("", "user", "hi"), message("", "bot", "hello")},
+			calls:    []string{"/v1/conversation/conv_1/message/create"},
+			expected: []string{"m1", "new"},
+		},
+		"removed message is deleted": {
+			current:  []ConversationMessageModel{message("m1", "user", "hi"), message("m2", "bot", "hello")},
+			desired:  []ConversationMessageModel{message("", "user", "hi")},
+			calls:    []string{"/v1/conversation/conv_1/message/m2/delete"},
+			expected: []string{"m1"},
+		},
+		"message removed from the middle is deleted alone": {
+			current:  []ConversationMessageModel{message("m1", "user", "hi"), message("m2", "bot", "hello"), message("m3", "user", "bye")},
+			desired:  []ConversationMessageModel{message("", "user", "hi"), message("", "user", "bye")},
+			calls:    []string{"/v1/conversation/conv_1/message/m2/delete"},
+			expected: []string{"m1", "m3"},
+		},
+		"message inserted in the middle rewrites the tail": {
+			current:  []ConversationMessageModel{message("m1", "user", "hi"), message("m2", "user", "bye")},
+			desired:  []ConversationMessageModel{message("", "user", "hi"), message("", "bot", "hello"), message("", "user", "bye")},
+			calls:    []string{"/v1/conversation/conv_1/message/m2/update", "/v1/conversation/conv_1/message/create"},
+			expected: []string{"m1", "m2", "new"},
+		},
+		"messages removed from the front are deleted": {
+			current:  []ConversationMessageModel{message("m1", "context", "ctx"), message("m2", "user", "hi"), message("m3", "bot", "hello")},
+			desired:  []ConversationMessageModel{message("", "user", "hi"), message("", "bot", "hello")},
+			calls:    []string{"/v1/conversation/conv_1/message/m1/delete"},
+			expected: []string{"m2", "m3"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": "new"})
+			}))
+			defer server.Close()
+
+			result, err := syncConversationMessages(context.Background(), NewClient("test-api-key", server.URL), "conv_1", test.current, test.desired)
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if strings.Join(calls, ",") != strings.Join(test.calls, ",") {
+				t.Errorf("expected calls %v, got %v", test.calls, calls)
+			}
+
+			var ids []string
+			for _, m := range result {
+				ids = append(ids, m.ID.ValueString())
+			}
+			if strings.Join(ids, ",") != strings.Join(test.expected, ",") {
+				t.Errorf("expected message IDs %v, got %v", test.expected, ids)
+			}
+		})
+	}
+}
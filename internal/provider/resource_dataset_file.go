@@ -0,0 +1,329 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &DatasetFileResource{}
+	_ resource.ResourceWithImportState = &DatasetFileResource{}
+)
+
+// datasetFileAttachmentType is the attachment type used for files whose
+// content is ingested into the dataset.
+const datasetFileAttachmentType = "source"
+
+// defaultDatasetFileSyncTimeout is how long to wait for ingestion when
+// sync_timeout is not set.
+const defaultDatasetFileSyncTimeout = 10 * time.Minute
+
+// datasetFileSyncPollInterval is how often the sync status is checked while
+// waiting for ingestion. It is a variable so that tests can shorten it.
+var datasetFileSyncPollInterval = 5 * time.Second
+
+func NewDatasetFileResource() resource.Resource {
+	return &DatasetFileResource{}
+}
+
+// DatasetFileResource defines the resource implementation.
+type DatasetFileResource struct {
+	client *Client
+}
+
+// DatasetFileResourceModel describes the resource data model.
+type DatasetFileResourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	DatasetId types.String `tfsdk:"dataset_id"`
+	FileId types.String `tfsdk:"file_id"`
+	FileContentSha256 types.String `tfsdk:"file_content_sha256"`
+	WaitForSync types.Bool `tfsdk:"wait_for_sync"`
+	SyncTimeout types.Int64 `tfsdk:"sync_timeout"`
+	SyncStatus types.String `tfsdk:"sync_status"`
+}
+
+// Metadata returns the resource type name.
+func (r *DatasetFileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataset_file"
+}
+
+// Schema defines the schema for the resource.
+func (r *DatasetFileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Attaches a file to a dataset and syncs its content into the dataset",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the attachment in the form `dataset_id/file_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"dataset_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the dataset to attach the file to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the file to attach",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_content_sha256": schema.StringAttribute{
+				MarkdownDescription: "The content hash of the file, usually `chatbotkit_file.<name>.content_sha256`. A change triggers a new sync",
+				Optional:            true,
+			},
+			"wait_for_sync": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait for ingestion to finish after each sync",
+				Optional:            true,
+			},
+			"sync_timeout": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of seconds to wait for ingestion when `wait_for_sync` is enabled. Must be at least 1. Defaults to 600",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64AtLeast(1),
+				},
+			},
+			"sync_status": schema.StringAttribute{
+				MarkdownDescription: "The sync status of the file in the dataset",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *DatasetFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *DatasetFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DatasetFileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit API to attach the file to the dataset
+
+	datasetId := data.DatasetId.ValueString()
+	fileId := data.FileId.ValueString()
+
+	attachmentType := datasetFileAttachmentType
+	_, err := r.client.AttachDatasetFile(ctx, datasetId, fileId, AttachDatasetFileInput{
+		Type: &attachmentType,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to attach file to dataset: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(datasetId + "/" + fileId)
+
+	nullUnknownStrings(&data.SyncStatus)
+
+	// Save data into Terraform state before syncing so that a failed sync
+	// does not leave the attachment unmanaged
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.sync(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to sync dataset file: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *DatasetFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DatasetFileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit API to read the dataset file
+
+	result, err := r.client.GetDatasetFile(ctx, data.DatasetId.ValueString(), data.FileId.ValueString())
+	if err != nil {
+		// Check if the file was detached outside of Terraform
+		if strings.Contains(err.Error(), "not found") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dataset file: %s", err))
+		return
+	}
+
+	// Update data model with response values
+
+	data.ID = types.StringValue(data.DatasetId.ValueString() + "/" + data.FileId.ValueString())
+	data.SyncStatus = types.StringPointerValue(result.SyncStatus)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *DatasetFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DatasetFileResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only a change in the file content requires a new sync
+	if data.FileContentSha256.Equal(state.FileContentSha256) {
+		data.SyncStatus = state.SyncStatus
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	if err := r.sync(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to sync dataset file: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *DatasetFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DatasetFileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit API to detach the file, the file itself is kept
+
+	_, err := r.client.DetachDatasetFile(ctx, data.DatasetId.ValueString(), data.FileId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to detach file from dataset: %s", err))
+		return
+	}
+}
+
+// ImportState imports the resource state from Terraform.
+func (r *DatasetFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID in the form dataset_id/file_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dataset_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("file_id"), parts[1])...)
+}
+
+// sync triggers ingestion of the file and, when requested, waits for it to
+// finish. The latest sync status is recorded in data.
+func (r *DatasetFileResource) sync(ctx context.Context, data *DatasetFileResourceModel) error {
+	datasetId := data.DatasetId.ValueString()
+	fileId := data.FileId.ValueString()
+
+	if _, err := r.client.SyncDatasetFile(ctx, datasetId, fileId); err != nil {
+		return err
+	}
+
+	if !data.WaitForSync.ValueBool() {
+		data.SyncStatus = types.StringNull()
+		return nil
+	}
+
+	timeout := defaultDatasetFileSyncTimeout
+	if !data.SyncTimeout.IsNull() && !data.SyncTimeout.IsUnknown() {
+		timeout = time.Duration(data.SyncTimeout.ValueInt64()) * time.Second
+	}
+
+	status, err := waitForDatasetFileSync(ctx, r.client, datasetId, fileId, timeout)
+	data.SyncStatus = types.StringValue(status)
+
+	return err
+}
+
+// waitForDatasetFileSync polls the sync status of a dataset file until it
+// leaves the pending states, fails or the timeout expires.
+func waitForDatasetFileSync(ctx context.Context, client *Client, datasetId string, fileId string, timeout time.Duration) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		result, err := client.GetDatasetFile(ctx, datasetId, fileId)
+		if err != nil {
+			return "", err
+		}
+
+		status := ""
+		if result.SyncStatus != nil {
+			status = *result.SyncStatus
+		}
+
+		switch status {
+		case "pending", "queued", "syncing", "processing":
+		case "failed", "error":
+			return status, fmt.Errorf("ingestion of file %s into dataset %s failed", fileId, datasetId)
+		default:
+			return status, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, fmt.Errorf("timed out after %s waiting for file %s to sync into dataset %s", timeout, fileId, datasetId)
+		case <-time.After(datasetFileSyncPollInterval):
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWaitForDatasetFileSync(t *testing.T) {
+	pollInterval := datasetFileSyncPollInterval
+	t.Cleanup(func() { datasetFileSyncPollInterval = pollInterval })
+	datasetFileSyncPollInterval = time.Millisecond
+
+	newServer := func(statuses ...string) *httptest.Server {
+		requests := 0
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			status := statuses[len(statuses)-1]
+			if requests < len(statuses) {
+				status = statuses[requests]
+			}
+			requests++
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"items": []map[string]interface{}{
+					{"id": "file_456", "syncStatus": status},
+				},
+			})
+		}))
+	}
+
+	t.Run("waits until synced", func(t *testing.T) {
+		server := newServer("pending", "syncing", "synced")
+		defer server.Close()
+
+		status, err := waitForDatasetFileSync(context.Background(), NewClient("test-api-key", server.URL), "dataset_123", "file_456", time.Second)
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if status != "synced" {
+			t.Errorf("expected status 'synced', got '%s'", status)
+		}
+	})
+
+	t.Run("returns error on failed ingestion", func(t *testing.T) {
+		server := newServer("syncing", "failed")
+		defer server.Close()
+
+		status, err := waitForDatasetFileSync(context.Background(), NewClient("test-api-key", server.URL), "dataset_123", "file_456", time.Second)
+
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if status != "failed" {
+			t.Errorf("expected status 'failed', got '%s'", status)
+		}
+	})
+
+	t.Run("times out while pending", func(t *testing.T) {
+		server := newServer("pending")
+		defer server.Close()
+
+		_, err := waitForDatasetFileSync(context.Background(), NewClient("test-api-key", server.URL), "dataset_123", "file_456", 20*time.Millisecond)
+
+		if err == nil {
+			t.Fatal("expected timeout error, got nil")
+		}
+	})
+}
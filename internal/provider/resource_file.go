@@ -1,8 +1,18 @@
 package provider
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -17,6 +27,8 @@ import (
 var (
 	_ resource.Resource                = &FileResource{}
 	_ resource.ResourceWithImportState = &FileResource{}
+	_ resource.ResourceWithValidateConfig = &FileResource{}
+	_ resource.ResourceWithModifyPlan = &FileResource{}
 )
 
 func NewFileResource() resource.Resource {
@@ -37,6 +49,11 @@ type FileResourceModel struct {
 	Meta types.Map `tfsdk:"meta"`
 	Name types.String `tfsdk:"name"`
 	Visibility types.String `tfsdk:"visibility"`
+	Source types.String `tfsdk:"source"`
+	Content types.String `tfsdk:"content"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	ContentType types.String `tfsdk:"content_type"`
+	ContentSha256 types.String `tfsdk:"content_sha256"`
 	CreatedAt types.String `tfsdk:"created_at"`
 	UpdatedAt types.String `tfsdk:"updated_at"`
 }
@@ -80,6 +97,27 @@ func (r *FileResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				MarkdownDescription: "The visibility level of the file",
 				Optional:            true,
 			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Path to a local file to upload as the file content. Conflicts with `content` and `content_base64`",
+				Optional:            true,
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Inline UTF-8 content to upload as the file content. Conflicts with `source` and `content_base64`",
+				Optional:            true,
+			},
+			"content_base64": schema.StringAttribute{
+				MarkdownDescription: "Base64-encoded binary content to upload as the file content. Conflicts with `source` and `content`",
+				Optional:            true,
+			},
+			"content_type": schema.StringAttribute{
+				MarkdownDescription: "The MIME type of the uploaded content. Detected from the file extension or the content itself when not set. A change triggers a new upload",
+				Optional:            true,
+				Computed:            true,
+			},
+			"content_sha256": schema.StringAttribute{
+				MarkdownDescription: "The SHA-256 hash of the uploaded content. A change in the content triggers a new upload",
+				Computed:            true,
+			},
 			"created_at": schema.StringAttribute{
 				MarkdownDescription: "Timestamp when the resource was created",
 				Computed:            true,
@@ -140,6 +178,23 @@ func (r *FileResource) Create(ctx context.Context, req resource.CreateRequest, r
 		data.ID = types.StringPointerValue(result.ID)
 	}
 
+	nullUnknownStrings(&data.CreatedAt, &data.UpdatedAt)
+
+	if hasFileContent(&data) {
+		if err := r.uploadContent(ctx, &data); err != nil {
+			// Keep the created file in state so that it is not orphaned. The
+			// failed create taints the resource, so the next apply deletes
+			// it and creates a replacement rather than retrying the upload
+			data.ContentSha256 = types.StringNull()
+			nullUnknownStrings(&data.ContentType)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upload file content, the file will be replaced on the next apply: %s", err))
+			return
+		}
+	}
+
+	nullUnknownStrings(&data.ContentType, &data.ContentSha256)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -200,10 +255,11 @@ func (r *FileResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *FileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data FileResourceModel
+	var data, state FileResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -223,6 +279,17 @@ func (r *FileResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	nullUnknownStrings(&data.CreatedAt, &data.UpdatedAt)
+
+	if needsUpload(&data, &state) {
+		if err := r.uploadContent(ctx, &data); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upload file content: %s", err))
+			return
+		}
+	}
+
+	nullUnknownStrings(&data.ContentType, &data.ContentSha256)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -251,3 +318,201 @@ func (r *FileResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 func (r *FileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// ValidateConfig ensures that at most one content source is configured.
+func (r *FileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data FileResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configured := 0
+	for _, v := range []types.String{data.Source, data.Content, data.ContentBase64} {
+		if !v.IsNull() {
+			configured++
+		}
+	}
+
+	if configured > 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"Conflicting File Content",
+			"Only one of source, content or content_base64 can be set.",
+		)
+		return
+	}
+
+	if !data.ContentBase64.IsNull() && !data.ContentBase64.IsUnknown() {
+		if _, err := base64.StdEncoding.DecodeString(data.ContentBase64.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("content_base64"),
+				"Invalid Base64 Content",
+				fmt.Sprintf("The content_base64 value is not valid base64: %s", err),
+			)
+		}
+	}
+}
+
+// ModifyPlan hashes the configured content so that a change in the content,
+// including a change to the local file behind source, triggers a new upload.
+func (r *FileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data FileResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var contentType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("content_type"), &contentType)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case data.Source.IsUnknown() || data.Content.IsUnknown() || data.ContentBase64.IsUnknown():
+		// The content is only known during apply
+		data.ContentSha256 = types.StringUnknown()
+		if contentType.IsNull() {
+			data.ContentType = types.StringUnknown()
+		}
+	case !hasFileContent(&data):
+		data.ContentSha256 = types.StringNull()
+		if contentType.IsNull() {
+			data.ContentType = types.StringNull()
+		}
+	default:
+		sum, detected, err := inspectFileContent(&data)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid File Content", fmt.Sprintf("Unable to read file content: %s", err))
+			return
+		}
+		data.ContentSha256 = types.StringValue(sum)
+		if contentType.IsNull() {
+			data.ContentType = types.StringValue(detected)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), data.ContentSha256)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_type"), data.ContentType)...)
+}
+
+// needsUpload reports whether the planned content has to be uploaded again,
+// which is the case when its hash or its content type has changed.
+func needsUpload(data *FileResourceModel, state *FileResourceModel) bool {
+	if !hasFileContent(data) {
+		return false
+	}
+	if data.ContentSha256.IsUnknown() || !data.ContentSha256.Equal(state.ContentSha256) {
+		return true
+	}
+	return data.ContentType.IsUnknown() || !data.ContentType.Equal(state.ContentType)
+}
+
+// uploadContent streams the configured content to the file and records the
+// content type and hash of what was uploaded.
+func (r *FileResource) uploadContent(ctx context.Context, data *FileResourceModel) error {
+	body, name, err := openFileContent(data)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	reader := bufio.NewReader(body)
+
+	contentType := data.ContentType.ValueString()
+	if data.ContentType.IsNull() || data.ContentType.IsUnknown() {
+		// Peek returns an error for content shorter than the sniff length,
+		// which is expected and can be ignored
+		head, _ := reader.Peek(512)
+		contentType = detectContentType(name, head)
+	}
+
+	hash := sha256.New()
+
+	_, err = r.client.UploadFile(ctx, data.ID.ValueString(), UploadFileInput{
+		Name:        name,
+		ContentType: contentType,
+		Body:        io.TeeReader(reader, hash),
+	})
+	if err != nil {
+		return err
+	}
+
+	data.ContentType = types.StringValue(contentType)
+	data.ContentSha256 = types.StringValue(hex.EncodeToString(hash.Sum(nil)))
+
+	return nil
+}
+
+// hasFileContent reports whether any content source is configured.
+func hasFileContent(data *FileResourceModel) bool {
+	return !data.Source.IsNull() || !data.Content.IsNull() || !data.ContentBase64.IsNull()
+}
+
+// openFileContent returns a reader over the configured content together with
+// the file name to report for it. Files read from source keep their own base
+// name so that the extension drives content type detection; inline content
+// is reported under the name of the file resource.
+func openFileContent(data *FileResourceModel) (io.ReadCloser, string, error) {
+	name := data.Name.ValueString()
+
+	switch {
+	case !data.Source.IsNull():
+		f, err := os.Open(data.Source.ValueString())
+		if err != nil {
+			return nil, "", err
+		}
+		return f, filepath.Base(data.Source.ValueString()), nil
+	case !data.ContentBase64.IsNull():
+		decoded, err := base64.StdEncoding.DecodeString(data.ContentBase64.ValueString())
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid content_base64: %w", err)
+		}
+		return io.NopCloser(bytes.NewReader(decoded)), name, nil
+	default:
+		return io.NopCloser(strings.NewReader(data.Content.ValueString())), name, nil
+	}
+}
+
+// inspectFileContent reads the configured content once and returns its
+// SHA-256 hash and detected content type.
+func inspectFileContent(data *FileResourceModel) (string, string, error) {
+	body, name, err := openFileContent(data)
+	if err != nil {
+		return "", "", err
+	}
+	defer body.Close()
+
+	reader := bufio.NewReader(body)
+	head, _ := reader.Peek(512)
+	contentType := detectContentType(name, head)
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, reader); err != nil {
+		return "", "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), contentType, nil
+}
+
+// detectContentType returns the MIME type for the file extension of name,
+// falling back to sniffing the leading bytes of the content.
+func detectContentType(name string, head []byte) string {
+	if ext := filepath.Ext(name); ext != "" {
+		if contentType := mime.TypeByExtension(ext); contentType != "" {
+			return contentType
+		}
+	}
+	return http.DetectContentType(head)
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestInspectFileContentUsesSourceName(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "report.csv")
+	if err := os.WriteFile(source, []byte("a,b\n1,2\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	data := &FileResourceModel{
+		Name: types.StringValue("Quarterly Report"),
+		Source: types.StringValue(source),
+		Content: types.StringNull(),
+		ContentBase64: types.StringNull(),
+	}
+
+	body, name, err := openFileContent(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body.Close()
+	if name != "report.csv" {
+		t.Errorf("expected the source base name, got %q", name)
+	}
+
+	_, contentType, err := inspectFileContent(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contentType != "text/csv; charset=utf-8" {
+		t.Errorf("expected the content type of the source extension, got %q", contentType)
+	}
+}
+
+func TestNeedsUpload(t *testing.T) {
+	state := FileResourceModel{
+		ContentType: types.StringValue("text/plain; charset=utf-8"),
+		ContentSha256: types.StringValue("abc"),
+	}
+	planned := func(contentType types.String, sum types.String) *FileResourceModel {
+		return &FileResourceModel{
+			Content: types.StringValue("hello"),
+			Source: types.StringNull(),
+			ContentBase64: types.StringNull(),
+			ContentType: contentType,
+			ContentSha256: sum,
+		}
+	}
+
+	tests := map[string]struct {
+		data     *FileResourceModel
+		expected bool
+	}{
+		"unchanged content": {planned(state.ContentType, state.ContentSha256), false},
+		"changed content": {planned(state.ContentType, types.StringValue("def")), true},
+		"content known only during apply": {planned(types.StringUnknown(), types.StringUnknown()), true},
+		"changed content type": {planned(types.StringValue("text/markdown"), state.ContentSha256), true},
+		"no content": {&FileResourceModel{Content: types.StringNull(), Source: types.StringNull(), ContentBase64: types.StringNull()}, false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := needsUpload(test.data, &state); got != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
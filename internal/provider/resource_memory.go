@@ -0,0 +1,321 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &MemoryResource{}
+	_ resource.ResourceWithImportState    = &MemoryResource{}
+	_ resource.ResourceWithValidateConfig = &MemoryResource{}
+)
+
+func NewMemoryResource() resource.Resource {
+	return &MemoryResource{}
+}
+
+// MemoryResource defines the resource implementation.
+type MemoryResource struct {
+	client *Client
+}
+
+// MemoryResourceModel describes the resource data model.
+type MemoryResourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	BotId types.String `tfsdk:"bot_id"`
+	ContactId types.String `tfsdk:"contact_id"`
+	Description types.String `tfsdk:"description"`
+	Meta types.Map `tfsdk:"meta"`
+	Name types.String `tfsdk:"name"`
+	Text types.String `tfsdk:"text"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *MemoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_memory"
+}
+
+// Schema defines the schema for the resource.
+func (r *MemoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Input parameters for creating a new memory",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the memory",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"bot_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the bot the memory belongs to. Conflicts with `contact_id`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"contact_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the contact the memory belongs to. Conflicts with `bot_id`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the memory",
+				Optional:            true,
+			},
+			"meta": schema.MapAttribute{
+				MarkdownDescription: "Additional metadata for the memory",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the memory",
+				Optional:            true,
+			},
+			"text": schema.StringAttribute{
+				MarkdownDescription: "The text content of the memory",
+				Required:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was created",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the memory is scoped to at most one owner.
+func (r *MemoryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data MemoryResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.BotId.IsNull() && !data.ContactId.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("contact_id"),
+			"Conflicting Memory Scope",
+			"A memory can belong to a bot or to a contact, but not both. Set only one of bot_id or contact_id.",
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *MemoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *MemoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MemoryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit API to create memory
+
+	result, err := r.client.CreateMemory(ctx, CreateMemoryInput{
+		BotId: data.BotId.ValueStringPointer(),
+		ContactId: data.ContactId.ValueStringPointer(),
+		Description: data.Description.ValueStringPointer(),
+		Meta: convertMapToInterface(ctx, data.Meta),
+		Name: data.Name.ValueStringPointer(),
+		Text: data.Text.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create memory: %s", err))
+		return
+	}
+
+	// Set the ID from the response
+	if result.ID != nil {
+		data.ID = types.StringPointerValue(result.ID)
+	}
+
+	nullUnknownStrings(&data.CreatedAt, &data.UpdatedAt)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *MemoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MemoryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit API to read memory
+
+	result, err := r.client.GetMemory(ctx, data.ID.ValueString())
+	if err != nil {
+		// Check if resource was deleted outside of Terraform
+		if strings.Contains(err.Error(), "not found") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read memory: %s", err))
+		return
+	}
+
+	// Update data model with response values
+
+	if result.Description != nil {
+		data.Description = types.StringPointerValue(result.Description)
+	}
+	if result.Meta != nil {
+		mapValue, diags := types.MapValueFrom(ctx, types.StringType, result.Meta)
+		resp.Diagnostics.Append(diags...)
+		data.Meta = mapValue
+	}
+	if result.Name != nil {
+		data.Name = types.StringPointerValue(result.Name)
+	}
+	// Always take the remote text so edits made outside of Terraform show up
+	// as drift in the next plan
+	data.Text = types.StringValue("")
+	if result.Text != nil {
+		data.Text = types.StringPointerValue(result.Text)
+	}
+	if result.CreatedAt != nil {
+		data.CreatedAt = types.StringPointerValue(result.CreatedAt)
+	}
+	if result.UpdatedAt != nil {
+		data.UpdatedAt = types.StringPointerValue(result.UpdatedAt)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *MemoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MemoryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit API to update memory
+
+	_, err := r.client.UpdateMemory(ctx, data.ID.ValueString(), UpdateMemoryInput{
+		Description: data.Description.ValueStringPointer(),
+		Meta: convertMapToInterface(ctx, data.Meta),
+		Name: data.Name.ValueStringPointer(),
+		Text: data.Text.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update memory: %s", err))
+		return
+	}
+
+	nullUnknownStrings(&data.CreatedAt, &data.UpdatedAt)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *MemoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MemoryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit API to delete memory
+
+	_, err := r.client.DeleteMemory(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete memory: %s", err))
+		return
+	}
+}
+
+// ImportState imports the resource state from Terraform.
+//
+// The API does not report which bot or contact a memory belongs to, so scoped
+// memories are imported as `bot/<bot_id>/<memory_id>` or
+// `contact/<contact_id>/<memory_id>`. A bare memory ID imports an unscoped
+// memory.
+func (r *MemoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	scope, ownerID, memoryID, ok := parseMemoryImportID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected <memory_id>, bot/<bot_id>/<memory_id> or contact/<contact_id>/<memory_id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	if scope != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(scope+"_id"), ownerID)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), memoryID)...)
+}
+
+// parseMemoryImportID splits a memory import identifier into the owner scope
+// ("bot", "contact" or empty for an unscoped memory), the owner ID and the
+// memory ID.
+func parseMemoryImportID(id string) (scope, ownerID, memoryID string, ok bool) {
+	parts := strings.Split(id, "/")
+
+	switch {
+	case len(parts) == 1 && parts[0] != "":
+		return "", "", parts[0], true
+	case len(parts) == 3 && (parts[0] == "bot" || parts[0] == "contact") && parts[1] != "" && parts[2] != "":
+		return parts[0], parts[1], parts[2], true
+	default:
+		return "", "", "", false
+	}
+}
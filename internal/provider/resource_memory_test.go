@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestParseMemoryImportID(t *testing.T) {
+	tests := []struct {
+		id       string
+		scope    string
+		ownerID  string
+		memoryID string
+		ok       bool
+	}{
+		{"memory_1", "", "", "memory_1", true},
+		{"bot/bot_1/memory_1", "bot", "bot_1", "memory_1", true},
+		{"contact/contact_1/memory_1", "contact", "contact_1", "memory_1", true},
+		{"", "", "", "", false},
+		{"space/space_1/memory_1", "", "", "", false},
+		{"bot//memory_1", "", "", "", false},
+		{"contact/contact_1/", "", "", "", false},
+		{"bot/memory_1", "", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			scope, ownerID, memoryID, ok := parseMemoryImportID(tt.id)
+			if ok != tt.ok || scope != tt.scope || ownerID != tt.ownerID || memoryID != tt.memoryID {
+				t.Errorf("expected (%q, %q, %q, %v), got (%q, %q, %q, %v)",
+					tt.scope, tt.ownerID, tt.memoryID, tt.ok, scope, ownerID, memoryID, ok)
+			}
+		})
+	}
+}
+
+func TestMemoryScopeRequiresReplace(t *testing.T) {
+	var schemaResp resource.SchemaResponse
+	(&MemoryResource{}).Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	existing := tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})
+
+	tests := []struct {
+		name    string
+		state   types.String
+		plan    types.String
+		replace bool
+	}{
+		{"unchanged owner", types.StringValue("owner_1"), types.StringValue("owner_1"), false},
+		{"changed owner", types.StringValue("owner_1"), types.StringValue("owner_2"), true},
+		{"added owner", types.StringNull(), types.StringValue("owner_1"), true},
+		{"removed owner", types.StringValue("owner_1"), types.StringNull(), true},
+	}
+
+	for _, attribute := range []string{"bot_id", "contact_id"} {
+		modifiers := schemaResp.Schema.Attributes[attribute].(schema.StringAttribute).PlanModifiers
+
+		for _, tt := range tests {
+			t.Run(attribute+"/"+tt.name, func(t *testing.T) {
+				req := planmodifier.StringRequest{
+					State:       tfsdk.State{Raw: existing},
+					Plan:        tfsdk.Plan{Raw: existing},
+					StateValue:  tt.state,
+					PlanValue:   tt.plan,
+					ConfigValue: tt.plan,
+				}
+				resp := &planmodifier.StringResponse{PlanValue: tt.plan}
+				for _, modifier := range modifiers {
+					modifier.PlanModifyString(context.Background(), req, resp)
+				}
+
+				if resp.RequiresReplace != tt.replace {
+					t.Errorf("expected RequiresReplace %v, got %v", tt.replace, resp.RequiresReplace)
+				}
+			})
+		}
+	}
+}
@@ -94,6 +94,7 @@ func (r *NotionIntegrationResource) Schema(ctx context.Context, req resource.Sch
 			"token": schema.StringAttribute{
 				MarkdownDescription: "The Notion integration token",
 				Optional:            true,
+				Sensitive:           true,
 			},
 			"created_at": schema.StringAttribute{
 				MarkdownDescription: "Timestamp when the resource was created",
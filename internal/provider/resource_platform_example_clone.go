@@ -0,0 +1,540 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PlatformExampleCloneResource{}
+
+// clonedResourceOperation describes how to scope and delete one type of
+// resource created by cloning a platform example.
+type clonedResourceOperation struct {
+	Type   string
+	Scope  func(ctx context.Context, c *Client, id string, blueprintId *string) error
+	Delete func(ctx context.Context, c *Client, id string) error
+}
+
+// clonedResourceOperations lists the resource types a platform example can
+// create, in the order they are deleted. Dependent resources such as bots and
+// integrations are deleted before the skillsets, datasets and secrets they
+// reference. Skillset abilities are not listed because they are removed
+// together with their skillset.
+var clonedResourceOperations = []clonedResourceOperation{
+	{
+		Type: "discordIntegration",
+		Scope: func(ctx context.Context, c *Client, id string, blueprintId *string) error {
+			_, err := c.UpdateDiscordIntegration(ctx, id, UpdateDiscordIntegrationInput{BlueprintId: blueprintId})
+			return err
+		},
+		Delete: func(ctx context.Context, c *Client, id string) error {
+			_, err := c.DeleteDiscordIntegration(ctx, id)
+			return err
+		},
+	},
+	{
+		Type: "emailIntegration",
+		Scope: func(ctx context.Context, c *Client, id string, blueprintId *string) error {
+			_, err := c.UpdateEmailIntegration(ctx, id, UpdateEmailIntegrationInput{BlueprintId: blueprintId})
+			return err
+		},
+		Delete: func(ctx context.Context, c *Client, id string) error {
+			_, err := c.DeleteEmailIntegration(ctx, id)
+			return err
+		},
+	},
+	{
+		Type: "extractIntegration",
+		Scope: func(ctx context.Context, c *Client, id string, blueprintId *string) error {
+			_, err := c.UpdateExtractIntegration(ctx, id, UpdateExtractIntegrationInput{BlueprintId: blueprintId})
+			return err
+		},
+		Delete: func(ctx context.Context, c *Client, id string) error {
+			_, err := c.DeleteExtractIntegration(ctx, id)
+			return err
+		},
+	},
+	{
+		Type: "mcpserverIntegration",
+		Scope: func(ctx context.Context, c *Client, id string, blueprintId *string) error {
+			_, err := c.UpdateMcpserverIntegration(ctx, id, UpdateMcpserverIntegrationInput{BlueprintId: blueprintId})
+			return err
+		},
+		Delete: func(ctx context.Context, c *Client, id string) error {
+			_, err := c.DeleteMcpserverIntegration(ctx, id)
+			return err
+		},
+	},
+	{
+		Type: "messengerIntegration",
+		Scope: func(ctx context.Context, c *Client, id string, blueprintId *string) error {
+			_, err := c.UpdateMessengerIntegration(ctx, id, UpdateMessengerIntegrationInput{BlueprintId: blueprintId})
+			return err
+		},
+		Delete: func(ctx context.Context, c *Client, id string) error {
+			_, err := c.DeleteMessengerIntegration(ctx, id)
+			return err
+		},
+	},
+	{
+		Type: "notionIntegration",
+		Scope: func(ctx context.Context, c *Client, id string, blueprintId *string) error {
+			_, err := c.UpdateNotionIntegration(ctx, id, UpdateNotionIntegrationInput{BlueprintId: blueprintId})
+			return err
+		},
+		Delete: func(ctx context.Context, c *Client, id string) error {
+			_, err := c.DeleteNotionIntegration(ctx, id)
+			return err
+		},
+	},
+	{
+		Type: "sitemapIntegration",
+		Scope: func(ctx context.Context, c *Client, id string, blueprintId *string) error {
+			_, err := c.UpdateSitemapIntegration(ctx, id, UpdateSitemapIntegrationInput{BlueprintId: blueprintId})
+			return err
+		},
+		Delete: func(ctx context.Context, c *Client, id string) error {
+			_, err := c.DeleteSitemapIntegration(ctx, id)
+			return err
+		},
+	},
+	{
+		Type: "slackIntegration",
+		Scope: func(ctx context.Context, c *Client, id string, blueprintId *string) error {
+			_, err := c.UpdateSlackIntegration(ctx, id, UpdateSlackIntegrationInput{BlueprintId: blueprintId})
+			return err
+		},
+		Delete: func(ctx context.Context, c *Client, id string) error {
+			_, err := c.DeleteSlackIntegration(ctx, id)
+			return err
+		},
+	},
+	{
+		Type: "telegramIntegration",
+		Scope: func(ctx context.Context, c *Client, id string, blueprintId *string) error {
+			_, err := c.UpdateTelegramIntegration(ctx, id, UpdateTelegramIntegrationInput{BlueprintId: blueprintId})
+			return err
+		},
+		Delete: func(ctx context.Context, c *Client, id string) error {
+			_, err := c.DeleteTelegramIntegration(ctx, id)
+			return err
+		},
+	},
+	{
+		Type: "triggerIntegration",
+		Scope: func(ctx context.Context, c *Client, id string, blueprintId *string) error {
+			_, err := c.UpdateTriggerIntegration(ctx, id, UpdateTriggerIntegrationInput{BlueprintId: blueprintId})
+			return err
+		},
+		Delete: func(ctx context.Context, c *Client, id string) error {
+			_, err := c.DeleteTriggerIntegration(ctx, id)
+			return err
+		},
+	},
+	{
+		Type: "twilioIntegration",
+		Scope: func(ctx context.Context, c *Client, id string, blueprintId *string) error {
+			_, err := c.UpdateTwilioIntegration(ctx, id, UpdateTwilioIntegrationInput{BlueprintId: blueprintId})
+			return err
+		},
+		Delete: func(ctx context.Context, c *Client, id string) error {
+			_, err := c.DeleteTwilioIntegration(ctx, id)
+			return err
+		},
+	},
+	{
+		Type: "whatsAppIntegration",
+		Scope: func(ctx context.Context, c *Client, id string, blueprintId *string) error {
+			_, err := c.UpdateWhatsAppIntegration(ctx, id, UpdateWhatsAppIntegrationInput{BlueprintId: blueprintId})
+			return err
+		},
+		Delete: func(ctx context.Context, c *Client, id string) error {
+			_, err := c.DeleteWhatsAppIntegration(ctx, id)
+			return err
+		},
+	},
+	{
+		Type: "portal",
+		Scope: func(ctx context.Context, c *Client, id string, blueprintId *string) error {
+			_, err := c.UpdatePortal(ctx, id, UpdatePortalInput{BlueprintId: blueprintId})
+			return err
+		},
+		Delete: func(ctx context.Context, c *Client, id string) error {
+			_, err := c.DeletePortal(ctx, id)
+			return err
+		},
+	},
+	{
+		Type: "task",
+		Scope: func(ctx context.Context, c *Client, id string, blueprintId *string) error {
+			_, err := c.UpdateTask(ctx, id, UpdateTaskInput{BlueprintId: blueprintId})
+			return err
+		},
+		Delete: func(ctx context.Context, c *Client, id string) error {
+			_, err := c.DeleteTask(ctx, id)
+			return err
+		},
+	},
+	{
+		Type: "bot",
+		Scope: func(ctx context.Context, c *Client, id string, blueprintId *string) error {
+			_, err := c.UpdateBot(ctx, id, UpdateBotInput{BlueprintId: blueprintId})
+			return err
+		},
+		Delete: func(ctx context.Context, c *Client, id string) error {
+			_, err := c.DeleteBot(ctx, id)
+			return err
+		},
+	},
+	{
+		Type: "skillset",
+		Scope: func(ctx context.Context, c *Client, id string, blueprintId *string) error {
+			_, err := c.UpdateSkillset(ctx, id, UpdateSkillsetInput{BlueprintId: blueprintId})
+			return err
+		},
+		Delete: func(ctx context.Context, c *Client, id string) error {
+			_, err := c.DeleteSkillset(ctx, id)
+			return err
+		},
+	},
+	{
+		Type: "dataset",
+		Scope: func(ctx context.Context, c *Client, id string, blueprintId *string) error {
+			_, err := c.UpdateDataset(ctx, id, UpdateDatasetInput{BlueprintId: blueprintId})
+			return err
+		},
+		Delete: func(ctx context.Context, c *Client, id string) error {
+			_, err := c.DeleteDataset(ctx, id)
+			return err
+		},
+	},
+	{
+		Type: "space",
+		Scope: func(ctx context.Context, c *Client, id string, blueprintId *string) error {
+			_, err := c.UpdateSpace(ctx, id, UpdateSpaceInput{BlueprintId: blueprintId})
+			return err
+		},
+		Delete: func(ctx context.Context, c *Client, id string) error {
+			_, err := c.DeleteSpace(ctx, id)
+			return err
+		},
+	},
+	{
+		Type: "file",
+		Scope: func(ctx context.Context, c *Client, id string, blueprintId *string) error {
+			_, err := c.UpdateFile(ctx, id, UpdateFileInput{BlueprintId: blueprintId})
+			return err
+		},
+		Delete: func(ctx context.Context, c *Client, id string) error {
+			_, err := c.DeleteFile(ctx, id)
+			return err
+		},
+	},
+	{
+		Type: "secret",
+		Scope: func(ctx context.Context, c *Client, id string, blueprintId *string) error {
+			_, err := c.UpdateSecret(ctx, id, UpdateSecretInput{BlueprintId: blueprintId})
+			return err
+		},
+		Delete: func(ctx context.Context, c *Client, id string) error {
+			_, err := c.DeleteSecret(ctx, id)
+			return err
+		},
+	},
+}
+
+func NewPlatformExampleCloneResource() resource.Resource {
+	return &PlatformExampleCloneResource{}
+}
+
+// PlatformExampleCloneResource defines the resource implementation.
+type PlatformExampleCloneResource struct {
+	client *Client
+}
+
+// PlatformExampleCloneResourceModel describes the resource data model.
+type PlatformExampleCloneResourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	ExampleId types.String `tfsdk:"example_id"`
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	Resources types.Map `tfsdk:"resources"`
+	BotIds types.List `tfsdk:"bot_ids"`
+	DatasetIds types.List `tfsdk:"dataset_ids"`
+	SkillsetIds types.List `tfsdk:"skillset_ids"`
+}
+
+// Metadata returns the resource type name.
+func (r *PlatformExampleCloneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_platform_example_clone"
+}
+
+// Schema defines the schema for the resource.
+func (r *PlatformExampleCloneResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Clones a platform example into the account and manages the resources it creates",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the clone",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"example_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the platform example to clone",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"blueprint_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of a blueprint to assign every created resource to",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resources": schema.MapAttribute{
+				MarkdownDescription: "The IDs of the created resources, keyed by resource type",
+				Computed:            true,
+				ElementType:         types.ListType{ElemType: types.StringType},
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bot_ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the created bots",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"dataset_ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the created datasets",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"skillset_ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the created skillsets",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *PlatformExampleCloneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *PlatformExampleCloneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PlatformExampleCloneResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit GraphQL API to clone the platform example
+
+	result, err := r.client.ClonePlatformExample(ctx, data.ExampleId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clone platform example: %s", err))
+		return
+	}
+
+	created := clonedResourceIds(result.Resources)
+
+	data.ID = types.StringValue(data.ExampleId.ValueString())
+	if ids := clonedResourceIdList(created); len(ids) > 0 {
+		data.ID = types.StringValue(data.ExampleId.ValueString() + "/" + ids[0])
+	}
+
+	resources, diags := types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, created)
+	resp.Diagnostics.Append(diags...)
+	data.Resources = resources
+
+	for attr, resourceType := range map[*types.List]string{
+		&data.BotIds:      "bot",
+		&data.DatasetIds:  "dataset",
+		&data.SkillsetIds: "skillset",
+	} {
+		ids := created[resourceType]
+		if ids == nil {
+			ids = []string{}
+		}
+		list, diags := types.ListValueFrom(ctx, types.StringType, ids)
+		resp.Diagnostics.Append(diags...)
+		*attr = list
+	}
+
+	// Save data into Terraform state before scoping so that the created
+	// resources are tracked even if scoping fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.BlueprintId.IsNull() {
+		return
+	}
+
+	blueprintId := data.BlueprintId.ValueStringPointer()
+	for _, op := range clonedResourceOperations {
+		for _, id := range created[op.Type] {
+			if err := op.Scope(ctx, r.client, id, blueprintId); err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to assign %s %s to blueprint: %s", op.Type, id, err))
+				return
+			}
+		}
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *PlatformExampleCloneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PlatformExampleCloneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The created resources are independent objects once cloned and are
+	// managed through their own resources, so the recorded IDs are kept as is
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *PlatformExampleCloneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute requires replacement, so there is nothing
+	// to update in place
+	var data PlatformExampleCloneResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *PlatformExampleCloneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PlatformExampleCloneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created := map[string][]string{}
+	resp.Diagnostics.Append(data.Resources.ElementsAs(ctx, &created, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit GraphQL API to delete every created resource
+
+	known := map[string]bool{}
+	for _, op := range clonedResourceOperations {
+		known[op.Type] = true
+		for _, id := range created[op.Type] {
+			if err := op.Delete(ctx, r.client, id); err != nil && !strings.Contains(err.Error(), "not found") {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete %s %s: %s", op.Type, id, err))
+				return
+			}
+		}
+	}
+
+	for resourceType, ids := range created {
+		if !known[resourceType] && resourceType != "skillsetAbility" && len(ids) > 0 {
+			resp.Diagnostics.AddWarning(
+				"Cloned Resources Not Deleted",
+				fmt.Sprintf("The provider cannot delete resources of type %s, remove them manually: %s", resourceType, strings.Join(ids, ", ")),
+			)
+		}
+	}
+}
+
+// clonedResourceIds extracts the created resource IDs from the result of
+// clonePlatformExample. Resource types are normalised to the singular camel
+// case names used by the API types, and each entry may be either an object
+// with an id field or a bare ID.
+func clonedResourceIds(resources map[string]interface{}) map[string][]string {
+	known := map[string]bool{"skillsetAbility": true}
+	for _, op := range clonedResourceOperations {
+		known[op.Type] = true
+	}
+
+	created := map[string][]string{}
+	for key, value := range resources {
+		resourceType := key
+		if singular := strings.TrimSuffix(key, "s"); !known[key] && known[singular] {
+			resourceType = singular
+		}
+
+		items, ok := value.([]interface{})
+		if !ok {
+			items = []interface{}{value}
+		}
+
+		for _, item := range items {
+			switch v := item.(type) {
+			case string:
+				created[resourceType] = append(created[resourceType], v)
+			case map[string]interface{}:
+				if id, ok := v["id"].(string); ok {
+					created[resourceType] = append(created[resourceType], id)
+				}
+			}
+		}
+	}
+
+	return created
+}
+
+// clonedResourceIdList returns every created ID in a stable order.
+func clonedResourceIdList(created map[string][]string) []string {
+	var ids []string
+	for _, v := range created {
+		ids = append(ids, v...)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestClonedResourceOperations(t *testing.T) {
+	server, calls := newRESTServer(t, func(r *http.Request) (int, interface{}) {
+		return http.StatusOK, map[string]interface{}{"id": "item_123"}
+	})
+	client := NewClient("test-api-key", server.URL)
+	ctx := context.Background()
+
+	operations := map[string]clonedResourceOperation{}
+	for _, operation := range clonedResourceOperations {
+		operations[operation.Type] = operation
+	}
+
+	var expected []struct{ method, path, field string }
+	for _, resourceType := range []string{"task", "space"} {
+		operation, ok := operations[resourceType]
+		if !ok {
+			t.Fatalf("expected an operation for '%s'", resourceType)
+		}
+		if err := operation.Scope(ctx, client, "item_123", ptr("blueprint_123")); err != nil {
+			t.Fatalf("expected no scope error for '%s', got %v", resourceType, err)
+		}
+		if err := operation.Delete(ctx, client, "item_123"); err != nil {
+			t.Fatalf("expected no delete error for '%s', got %v", resourceType, err)
+		}
+		expected = append(expected,
+			struct{ method, path, field string }{"POST", "/v1/" + resourceType + "/item_123/update", "blueprintId"},
+			struct{ method, path, field string }{"POST", "/v1/" + resourceType + "/item_123/delete", ""},
+		)
+	}
+
+	assertRESTCalls(t, *calls, expected)
+}
@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -17,8 +21,17 @@ import (
 var (
 	_ resource.Resource                = &SecretResource{}
 	_ resource.ResourceWithImportState = &SecretResource{}
+	_ resource.ResourceWithModifyPlan  = &SecretResource{}
 )
 
+// defaultSecretVerificationTimeout is how long to wait for verification when
+// verification_timeout is not set.
+const defaultSecretVerificationTimeout = 10 * time.Minute
+
+// secretVerificationPollInterval is how often the verification status is
+// checked while waiting for it. It is a variable so that tests can shorten it.
+var secretVerificationPollInterval = 5 * time.Second
+
 func NewSecretResource() resource.Resource {
 	return &SecretResource{}
 }
@@ -41,10 +54,40 @@ type SecretResourceModel struct {
 	Type types.String `tfsdk:"type"`
 	Value types.String `tfsdk:"value"`
 	Visibility types.String `tfsdk:"visibility"`
+	RevokeOnDestroy types.Bool `tfsdk:"revoke_on_destroy"`
+	RevocationTrigger types.String `tfsdk:"revocation_trigger"`
+	PlatformSecretId types.String `tfsdk:"platform_secret_id"`
+	WaitForVerification types.Bool `tfsdk:"wait_for_verification"`
+	VerificationTimeout types.Int64 `tfsdk:"verification_timeout"`
+	VerificationStatus types.String `tfsdk:"verification_status"`
+	VerificationUrl types.String `tfsdk:"verification_url"`
+	Contacts types.List `tfsdk:"contacts"`
 	CreatedAt types.String `tfsdk:"created_at"`
 	UpdatedAt types.String `tfsdk:"updated_at"`
 }
 
+// SecretContactModel describes a contact that authenticated a personal secret.
+type SecretContactModel struct {
+	ID types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Nick types.String `tfsdk:"nick"`
+	Email types.String `tfsdk:"email"`
+	Phone types.String `tfsdk:"phone"`
+	VerificationStatus types.String `tfsdk:"verification_status"`
+	VerificationUrl types.String `tfsdk:"verification_url"`
+}
+
+// secretContactAttrTypes describes the object type of the contacts attribute.
+var secretContactAttrTypes = map[string]attr.Type{
+	"id":                  types.StringType,
+	"name":                types.StringType,
+	"nick":                types.StringType,
+	"email":               types.StringType,
+	"phone":               types.StringType,
+	"verification_status": types.StringType,
+	"verification_url":    types.StringType,
+}
+
 // Metadata returns the resource type name.
 func (r *SecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_secret"
@@ -101,6 +144,73 @@ func (r *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				MarkdownDescription: "The visibility level of the secret",
 				Optional:            true,
 			},
+			"revoke_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to revoke the credentials granted to the secret, such as an OAuth grant, before deleting it",
+				Optional:            true,
+			},
+			"revocation_trigger": schema.StringAttribute{
+				MarkdownDescription: "An arbitrary value that revokes the credentials granted to the secret whenever it changes to a new value. Setting it for the first time or removing it does not revoke anything. The secret is kept and must be verified again",
+				Optional:            true,
+			},
+			"platform_secret_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the platform secret template this secret implements. A warning is shown at plan time when the secret kind or type does not match the template",
+				Optional:            true,
+			},
+			"wait_for_verification": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait for the secret to be authenticated after it is created or updated",
+				Optional:            true,
+			},
+			"verification_timeout": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of seconds to wait for authentication when `wait_for_verification` is enabled. Must be at least 1. Defaults to 600",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64AtLeast(1),
+				},
+			},
+			"verification_status": schema.StringAttribute{
+				MarkdownDescription: "The verification status of the secret, for example `authenticated` or `unauthenticated`",
+				Computed:            true,
+			},
+			"verification_url": schema.StringAttribute{
+				MarkdownDescription: "The URL to visit to authenticate the secret. Null when the secret is authenticated or needs no authentication",
+				Computed:            true,
+			},
+			"contacts": schema.ListNestedAttribute{
+				MarkdownDescription: "The contacts that authenticated the secret. Only personal secrets have contacts",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the contact",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the contact",
+							Computed:            true,
+						},
+						"nick": schema.StringAttribute{
+							MarkdownDescription: "The nickname of the contact",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The email address of the contact",
+							Computed:            true,
+						},
+						"phone": schema.StringAttribute{
+							MarkdownDescription: "The phone number of the contact",
+							Computed:            true,
+						},
+						"verification_status": schema.StringAttribute{
+							MarkdownDescription: "The verification status of the secret for the contact",
+							Computed:            true,
+						},
+						"verification_url": schema.StringAttribute{
+							MarkdownDescription: "The URL the contact has to visit to authenticate the secret",
+							Computed:            true,
+						},
+					},
+				},
+			},
 			"created_at": schema.StringAttribute{
 				MarkdownDescription: "Timestamp when the resource was created",
 				Computed:            true,
@@ -165,6 +275,8 @@ func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest,
 		data.ID = types.StringPointerValue(result.ID)
 	}
 
+	resp.Diagnostics.Append(r.refreshVerification(ctx, &data)...)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -233,16 +345,19 @@ func (r *SecretResource) Read(ctx context.Context, req resource.ReadRequest, res
 		data.UpdatedAt = types.StringPointerValue(result.UpdatedAt)
 	}
 
+	resp.Diagnostics.Append(setSecretVerification(ctx, &data, result)...)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *SecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data SecretResourceModel
+	var data, state SecretResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -266,10 +381,59 @@ func (r *SecretResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	// Save updated data into Terraform state
+	nullUnknownVerification(&data)
+
+	resp.Diagnostics.Append(r.revokeOnTriggerChange(ctx, &data, state)...)
+
+	if resp.Diagnostics.HasError() {
+		// Save the updated data with the trigger the revocation failed for
+		// rolled back, so that the next apply tries the revocation again
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	resp.Diagnostics.Append(r.refreshVerification(ctx, &data)...)
+
+	// Save the verification state into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// revokeOnTriggerChange revokes the granted credentials when the revocation
+// trigger changes. Setting the trigger for the first time or removing it does
+// not count as a change. When the revocation fails the trigger in data is
+// reset to its prior value so that the change is still pending on the next
+// apply.
+func (r *SecretResource) revokeOnTriggerChange(ctx context.Context, data *SecretResourceModel, state SecretResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if state.RevocationTrigger.IsNull() || data.RevocationTrigger.IsNull() || data.RevocationTrigger.Equal(state.RevocationTrigger) {
+		return diags
+	}
+
+	if _, err := r.client.RevokeSecret(ctx, data.ID.ValueString()); err != nil {
+		data.RevocationTrigger = state.RevocationTrigger
+		diags.AddError("Client Error", fmt.Sprintf("Unable to revoke secret: %s", err))
+		return diags
+	}
+
+	// Check the verification state again so that the need to re-authenticate
+	// is surfaced straight away
+	result, err := r.client.GetSecret(ctx, data.ID.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read secret after revocation: %s", err))
+		return diags
+	}
+	if v := result.Verification; v != nil && v.Status != nil && *v.Status != secretVerifiedStatus && !data.WaitForVerification.ValueBool() {
+		detail := fmt.Sprintf("The credentials of secret %s were revoked and the secret must be verified again.", data.ID.ValueString())
+		if v.Action != nil && v.Action.URL != nil {
+			detail += fmt.Sprintf(" Authenticate at: %s", *v.Action.URL)
+		}
+		diags.AddWarning("Secret Requires Verification", detail)
+	}
+
+	return diags
+}
+
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data SecretResourceModel
@@ -281,6 +445,16 @@ func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	// Revoke the granted credentials first so that they are invalidated
+	// upstream and not only forgotten
+
+	if data.RevokeOnDestroy.ValueBool() {
+		if _, err := r.client.RevokeSecret(ctx, data.ID.ValueString()); err != nil && !strings.Contains(err.Error(), "not found") {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke secret: %s", err))
+			return
+		}
+	}
+
 	// Call the ChatBotKit GraphQL API to delete secret
 
 	_, err := r.client.DeleteSecret(ctx, data.ID.ValueString())
@@ -290,6 +464,197 @@ func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// ModifyPlan warns when the secret does not match the platform secret
+// template it implements.
+func (r *SecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var data SecretResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.PlatformSecretId.IsNull() || data.PlatformSecretId.IsUnknown() || data.Kind.IsUnknown() || data.Type.IsUnknown() {
+		return
+	}
+
+	template, err := r.client.GetPlatformSecret(ctx, data.PlatformSecretId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("platform_secret_id"),
+			"Unable to Check Platform Secret",
+			fmt.Sprintf("The secret could not be compared with platform secret %s: %s", data.PlatformSecretId.ValueString(), err),
+		)
+		return
+	}
+
+	for _, mismatch := range platformSecretMismatches(template, data.Kind.ValueStringPointer(), data.Type.ValueStringPointer()) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root(mismatch.attribute),
+			"Secret Does Not Match Platform Secret",
+			fmt.Sprintf("The secret %s is %q but platform secret %s expects %q. Abilities that depend on the platform secret may not be able to use it.", mismatch.attribute, mismatch.actual, data.PlatformSecretId.ValueString(), mismatch.expected),
+		)
+	}
+}
+
+// refreshVerification reads the verification state of the secret into data
+// and, when requested, waits for the secret to be authenticated first. The
+// apply is not failed when the wait times out, since the secret itself was
+// saved; a warning with the authentication URL is returned instead.
+func (r *SecretResource) refreshVerification(ctx context.Context, data *SecretResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	nullUnknownVerification(data)
+
+	id := data.ID.ValueString()
+
+	var (
+		result *GetSecretResponse
+		err    error
+	)
+	if data.WaitForVerification.ValueBool() {
+		timeout := defaultSecretVerificationTimeout
+		if !data.VerificationTimeout.IsNull() && !data.VerificationTimeout.IsUnknown() {
+			timeout = time.Duration(data.VerificationTimeout.ValueInt64()) * time.Second
+		}
+
+		result, err = waitForSecretVerification(ctx, r.client, id, timeout)
+		if err != nil && result != nil {
+			detail := fmt.Sprintf("Secret %s was not authenticated in time: %s.", id, err)
+			if url := secretVerificationURL(result); url != nil {
+				detail += fmt.Sprintf(" Authenticate at: %s", *url)
+			}
+			diags.AddWarning("Secret Not Verified", detail)
+			err = nil
+		}
+	} else {
+		result, err = r.client.GetSecret(ctx, id)
+	}
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read secret verification: %s", err))
+		return diags
+	}
+
+	diags.Append(setSecretVerification(ctx, data, result)...)
+
+	return diags
+}
+
+// nullUnknownVerification replaces the unknown verification values planned
+// for a secret with null, since Terraform rejects unknown values in the state
+// returned after apply.
+func nullUnknownVerification(data *SecretResourceModel) {
+	nullUnknownStrings(&data.VerificationStatus, &data.VerificationUrl)
+	if data.Contacts.IsUnknown() {
+		data.Contacts = types.ListNull(types.ObjectType{AttrTypes: secretContactAttrTypes})
+	}
+}
+
+// setSecretVerification sets the verification status, URL and contacts of a
+// secret in data.
+func setSecretVerification(ctx context.Context, data *SecretResourceModel, secret *GetSecretResponse) diag.Diagnostics {
+	data.VerificationStatus = types.StringPointerValue(secretVerificationStatus(secret.Verification))
+	data.VerificationUrl = types.StringPointerValue(secretVerificationURL(secret))
+
+	contacts, diags := secretContactsValue(ctx, secret.Contacts)
+	data.Contacts = contacts
+
+	return diags
+}
+
+// secretVerificationStatus returns the status of a verification, or nil when
+// there is none.
+func secretVerificationStatus(v *SecretVerificationResponse) *string {
+	if v == nil {
+		return nil
+	}
+	return v.Status
+}
+
+// secretContactsValue converts the contacts of a secret into a list value.
+func secretContactsValue(ctx context.Context, contacts []*SecretContactResponse) (types.List, diag.Diagnostics) {
+	models := []SecretContactModel{}
+	for _, contact := range contacts {
+		if contact == nil {
+			continue
+		}
+		models = append(models, SecretContactModel{
+			ID: types.StringPointerValue(contact.ID),
+			Name: types.StringPointerValue(contact.Name),
+			Nick: types.StringPointerValue(contact.Nick),
+			Email: types.StringPointerValue(contact.Email),
+			Phone: types.StringPointerValue(contact.Phone),
+			VerificationStatus: types.StringPointerValue(secretVerificationStatus(contact.Verification)),
+			VerificationUrl: types.StringPointerValue(verificationURL(contact.Verification)),
+		})
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: secretContactAttrTypes}, models)
+}
+
+// waitForSecretVerification polls a secret until it is authenticated or the
+// timeout expires. Secrets without a verification status need no
+// authentication and are returned straight away. On timeout the last state of
+// the secret is returned together with the error.
+func waitForSecretVerification(ctx context.Context, client *Client, id string, timeout time.Duration) (*GetSecretResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var last *GetSecretResponse
+	for {
+		result, err := client.GetSecret(ctx, id)
+		if err != nil {
+			// The timeout may expire in the middle of a request
+			if ctx.Err() != nil && last != nil {
+				return last, fmt.Errorf("timed out after %s waiting for secret %s to be authenticated", timeout, id)
+			}
+			return nil, err
+		}
+		last = result
+
+		status := secretVerificationStatus(result.Verification)
+		if status == nil || *status == secretVerifiedStatus {
+			return result, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return result, fmt.Errorf("timed out after %s waiting for secret %s to be authenticated", timeout, id)
+		case <-time.After(secretVerificationPollInterval):
+		}
+	}
+}
+
+// platformSecretMismatch describes an attribute of a secret that differs from
+// its platform secret template.
+type platformSecretMismatch struct {
+	attribute string
+	expected  string
+	actual    string
+}
+
+// platformSecretMismatches compares the kind and type of a secret with the
+// platform secret template it implements. Attributes the template does not
+// define are not compared.
+func platformSecretMismatches(template *PlatformSecretResponse, kind *string, secretType *string) []platformSecretMismatch {
+	var mismatches []platformSecretMismatch
+
+	if template.Kind != nil && !equalStrings(kind, template.Kind) {
+		mismatches = append(mismatches, platformSecretMismatch{"kind", *template.Kind, stringValue(kind)})
+	}
+	if template.Type != nil && !equalStrings(secretType, template.Type) {
+		mismatches = append(mismatches, platformSecretMismatch{"type", *template.Type, stringValue(secretType)})
+	}
+
+	return mismatches
+}
+
 // ImportState imports the resource state from Terraform.
 func (r *SecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
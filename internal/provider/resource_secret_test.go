@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPlatformSecretMismatches(t *testing.T) {
+	template := &PlatformSecretResponse{Kind: ptr("personal"), Type: ptr("oauth")}
+
+	if got := platformSecretMismatches(template, ptr("personal"), ptr("oauth")); len(got) != 0 {
+		t.Errorf("expected no mismatches, got %v", got)
+	}
+
+	got := platformSecretMismatches(template, ptr("personal"), ptr("token"))
+	if len(got) != 1 || got[0].attribute != "type" || got[0].expected != "oauth" || got[0].actual != "token" {
+		t.Errorf("expected type mismatch, got %v", got)
+	}
+
+	got = platformSecretMismatches(template, nil, nil)
+	if len(got) != 2 {
+		t.Errorf("expected kind and type mismatches for unset attributes, got %v", got)
+	}
+
+	if got := platformSecretMismatches(&PlatformSecretResponse{}, nil, ptr("token")); len(got) != 0 {
+		t.Errorf("expected template without kind or type to match, got %v", got)
+	}
+}
+
+func TestWaitForSecretVerification(t *testing.T) {
+	pollInterval := secretVerificationPollInterval
+	t.Cleanup(func() { secretVerificationPollInterval = pollInterval })
+	secretVerificationPollInterval = time.Millisecond
+
+	newServer := func(statuses ...string) *httptest.Server {
+		requests := 0
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			status := statuses[len(statuses)-1]
+			if requests < len(statuses) {
+				status = statuses[requests]
+			}
+			requests++
+
+			node := map[string]interface{}{"id": "secret_123"}
+			if status != "" {
+				node["verification"] = map[string]interface{}{
+					"status": status,
+					"action": map[string]interface{}{"type": "authenticate", "url": "https://example.com/authenticate"},
+				}
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": map[string]interface{}{
+					"secrets": map[string]interface{}{
+						"edges": []map[string]interface{}{{"cursor": "c1", "node": node}},
+					},
+				},
+			})
+		}))
+	}
+
+	t.Run("waits until authenticated", func(t *testing.T) {
+		server := newServer("unauthenticated", "unauthenticated", "authenticated")
+		defer server.Close()
+
+		result, err := waitForSecretVerification(context.Background(), NewClient("test-api-key", server.URL), "secret_123", time.Second)
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if url := secretVerificationURL(result); url != nil {
+			t.Errorf("expected no verification URL once authenticated, got '%s'", *url)
+		}
+	})
+
+	t.Run("returns straight away without verification", func(t *testing.T) {
+		server := newServer("")
+		defer server.Close()
+
+		result, err := waitForSecretVerification(context.Background(), NewClient("test-api-key", server.URL), "secret_123", time.Second)
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.Verification != nil {
+			t.Errorf("expected no verification, got %v", result.Verification)
+		}
+	})
+
+	t.Run("times out with the last state", func(t *testing.T) {
+		server := newServer("unauthenticated")
+		defer server.Close()
+
+		result, err := waitForSecretVerification(context.Background(), NewClient("test-api-key", server.URL), "secret_123", 20*time.Millisecond)
+
+		if err == nil {
+			t.Fatal("expected timeout error, got nil")
+		}
+		if result == nil || secretVerificationURL(result) == nil || *secretVerificationURL(result) != "https://example.com/authenticate" {
+			t.Errorf("expected the last state with its verification URL, got %v", result)
+		}
+	})
+}
+
+func TestSecretContactsValue(t *testing.T) {
+	contacts, diags := secretContactsValue(context.Background(), []*SecretContactResponse{
+		{
+			ID: ptr("contact_1"),
+			Email: ptr("jane@example.com"),
+			Verification: &SecretVerificationResponse{
+				Status: ptr("unauthenticated"),
+				Action: &SecretVerificationActionResponse{Type: ptr("authenticate"), URL: ptr("https://example.com/authenticate")},
+			},
+		},
+		nil,
+	})
+	if diags.HasError() {
+		t.Fatalf("expected no error, got %v", diags)
+	}
+
+	var models []SecretContactModel
+	if diags := contacts.ElementsAs(context.Background(), &models, false); diags.HasError() {
+		t.Fatalf("expected contact models, got %v", diags)
+	}
+	if len(models) != 1 {
+		t.Fatalf("expected 1 contact, got %d", len(models))
+	}
+	if models[0].VerificationStatus.ValueString() != "unauthenticated" || models[0].VerificationUrl.ValueString() != "https://example.com/authenticate" {
+		t.Errorf("expected contact verification, got %v", models[0])
+	}
+	if !models[0].Name.IsNull() {
+		t.Errorf("expected null name, got %v", models[0].Name)
+	}
+}
+
+func TestRevokeOnTriggerChange(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"errors": []map[string]interface{}{{"message": "revocation failed"}},
+		})
+	}))
+	defer server.Close()
+
+	r := &SecretResource{client: NewClient("test-api-key", server.URL)}
+
+	t.Run("rolls the trigger back when revocation fails", func(t *testing.T) {
+		data := SecretResourceModel{ID: types.StringValue("secret_123"), RevocationTrigger: types.StringValue("2")}
+		state := SecretResourceModel{ID: types.StringValue("secret_123"), RevocationTrigger: types.StringValue("1")}
+
+		diags := r.revokeOnTriggerChange(context.Background(), &data, state)
+
+		if !diags.HasError() {
+			t.Fatal("expected an error")
+		}
+		if data.RevocationTrigger.ValueString() != "1" {
+			t.Errorf("expected the trigger to be rolled back to '1', got '%s'", data.RevocationTrigger.ValueString())
+		}
+	})
+
+	t.Run("does not revoke when the trigger is first set", func(t *testing.T) {
+		data := SecretResourceModel{ID: types.StringValue("secret_123"), RevocationTrigger: types.StringValue("1")}
+		state := SecretResourceModel{ID: types.StringValue("secret_123"), RevocationTrigger: types.StringNull()}
+
+		if diags := r.revokeOnTriggerChange(context.Background(), &data, state); diags.HasError() {
+			t.Fatalf("expected no revocation, got %v", diags)
+		}
+		if data.RevocationTrigger.ValueString() != "1" {
+			t.Errorf("expected the trigger to be kept, got '%s'", data.RevocationTrigger.ValueString())
+		}
+	})
+
+	t.Run("does not revoke when the trigger is removed", func(t *testing.T) {
+		data := SecretResourceModel{ID: types.StringValue("secret_123"), RevocationTrigger: types.StringNull()}
+		state := SecretResourceModel{ID: types.StringValue("secret_123"), RevocationTrigger: types.StringValue("1")}
+
+		if diags := r.revokeOnTriggerChange(context.Background(), &data, state); diags.HasError() {
+			t.Fatalf("expected no revocation, got %v", diags)
+		}
+		if !data.RevocationTrigger.IsNull() {
+			t.Errorf("expected the trigger to stay removed, got '%s'", data.RevocationTrigger.ValueString())
+		}
+	})
+}
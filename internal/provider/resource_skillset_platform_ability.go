@@ -0,0 +1,501 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &SkillsetPlatformAbilityResource{}
+	_ resource.ResourceWithImportState = &SkillsetPlatformAbilityResource{}
+)
+
+// secretVerifiedStatus is the verification status of a secret whose
+// credentials have been authorized.
+const secretVerifiedStatus = "authenticated"
+
+func NewSkillsetPlatformAbilityResource() resource.Resource {
+	return &SkillsetPlatformAbilityResource{}
+}
+
+// SkillsetPlatformAbilityResource defines the resource implementation.
+type SkillsetPlatformAbilityResource struct {
+	client *Client
+}
+
+// SkillsetPlatformAbilityResourceModel describes the resource data model.
+type SkillsetPlatformAbilityResourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	SkillsetId types.String `tfsdk:"skillset_id"`
+	PlatformAbilityId types.String `tfsdk:"platform_ability_id"`
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	Name types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Meta types.Map `tfsdk:"meta"`
+	SecretId types.String `tfsdk:"secret_id"`
+	Instruction types.String `tfsdk:"instruction"`
+	PlatformSecretId types.String `tfsdk:"platform_secret_id"`
+	VerificationUrl types.String `tfsdk:"verification_url"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *SkillsetPlatformAbilityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_skillset_platform_ability"
+}
+
+// Schema defines the schema for the resource.
+func (r *SkillsetPlatformAbilityResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Installs an ability from the platform catalog into a skillset, provisioning the secret it requires",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the skillset ability",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"skillset_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the skillset to install the ability into",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"platform_ability_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the ability in the platform catalog",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					// Imported abilities do not know their catalog ability,
+					// adopt the configured one instead of replacing them
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Changing the catalog ability of an installed ability requires replacement",
+						"Changing the catalog ability of an installed ability requires replacement",
+					),
+				},
+			},
+			"blueprint_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the blueprint the ability and any provisioned secret belong to",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the ability. Defaults to the catalog name",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the ability. Defaults to the catalog description",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"meta": schema.MapAttribute{
+				MarkdownDescription: "Additional metadata for the ability",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"secret_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the secret the ability authenticates with. When not set, a secret matching the catalog template is reused or created",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instruction": schema.StringAttribute{
+				MarkdownDescription: "The instruction copied from the catalog ability",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"platform_secret_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the platform secret template the ability requires, if any",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"verification_url": schema.StringAttribute{
+				MarkdownDescription: "The URL to visit to authorize the secret, set while the secret still requires OAuth authorization",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was created",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SkillsetPlatformAbilityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *SkillsetPlatformAbilityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SkillsetPlatformAbilityResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Look up the catalog ability
+
+	ability, err := r.client.GetPlatformAbility(ctx, data.PlatformAbilityId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read platform ability: %s", err))
+		return
+	}
+
+	if data.Name.IsUnknown() {
+		data.Name = types.StringPointerValue(ability.Name)
+	}
+	if data.Description.IsUnknown() {
+		data.Description = types.StringPointerValue(ability.Description)
+	}
+	data.Instruction = types.StringPointerValue(ability.Instruction)
+	data.PlatformSecretId = types.StringPointerValue(ability.Secret)
+
+	// Reuse or create the secret required by the ability
+
+	if data.SecretId.IsUnknown() {
+		data.SecretId = types.StringNull()
+
+		if ability.Secret != nil && *ability.Secret != "" {
+			secretId, err := r.provisionSecret(ctx, *ability.Secret, data.BlueprintId.ValueStringPointer())
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to provision secret for platform ability: %s", err))
+				return
+			}
+			data.SecretId = types.StringValue(secretId)
+		}
+	}
+
+	// Call the ChatBotKit GraphQL API to create skillsetability
+
+	result, err := r.client.CreateSkillsetAbility(ctx, data.SkillsetId.ValueString(), CreateSkillsetAbilityInput{
+		BlueprintId: data.BlueprintId.ValueStringPointer(),
+		Description: data.Description.ValueStringPointer(),
+		Instruction: data.Instruction.ValueStringPointer(),
+		Meta: convertMapToInterface(ctx, data.Meta),
+		Name: data.Name.ValueStringPointer(),
+		SecretId: data.SecretId.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create skillsetability: %s", err))
+		return
+	}
+
+	data.ID = types.StringPointerValue(result.ID)
+
+	nullUnknownStrings(&data.VerificationUrl, &data.CreatedAt, &data.UpdatedAt)
+
+	if err := r.refreshVerification(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secret: %s", err))
+		return
+	}
+
+	if !data.VerificationUrl.IsNull() {
+		resp.Diagnostics.AddWarning(
+			"Secret Requires Verification",
+			fmt.Sprintf("The ability %s uses secret %s which must be authorized before it can be used. Authenticate at: %s", data.Name.ValueString(), data.SecretId.ValueString(), data.VerificationUrl.ValueString()),
+		)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *SkillsetPlatformAbilityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SkillsetPlatformAbilityResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit GraphQL API to read skillsetability
+
+	result, err := r.client.GetSkillsetAbility(ctx, data.SkillsetId.ValueString(), data.ID.ValueString())
+	if err != nil {
+		// Check if resource was deleted outside of Terraform
+		if strings.Contains(err.Error(), "not found") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read skillsetability: %s", err))
+		return
+	}
+
+	// Update data model with response values
+
+	if result.BlueprintId != nil {
+		data.BlueprintId = types.StringPointerValue(result.BlueprintId)
+	}
+	if result.Description != nil {
+		data.Description = types.StringPointerValue(result.Description)
+	}
+	if result.Instruction != nil {
+		data.Instruction = types.StringPointerValue(result.Instruction)
+	}
+	if result.Meta != nil {
+		mapValue, diags := types.MapValueFrom(ctx, types.StringType, result.Meta)
+		resp.Diagnostics.Append(diags...)
+		data.Meta = mapValue
+	}
+	if result.Name != nil {
+		data.Name = types.StringPointerValue(result.Name)
+	}
+	data.SecretId = types.StringPointerValue(result.SecretId)
+	if result.CreatedAt != nil {
+		data.CreatedAt = types.StringPointerValue(result.CreatedAt)
+	}
+	if result.UpdatedAt != nil {
+		data.UpdatedAt = types.StringPointerValue(result.UpdatedAt)
+	}
+
+	if err := r.refreshVerification(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secret: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *SkillsetPlatformAbilityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SkillsetPlatformAbilityResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit GraphQL API to update skillsetability
+
+	_, err := r.client.UpdateSkillsetAbility(ctx, data.SkillsetId.ValueString(), data.ID.ValueString(), UpdateSkillsetAbilityInput{
+		BlueprintId: data.BlueprintId.ValueStringPointer(),
+		Description: data.Description.ValueStringPointer(),
+		Instruction: data.Instruction.ValueStringPointer(),
+		Meta: convertMapToInterface(ctx, data.Meta),
+		Name: data.Name.ValueStringPointer(),
+		SecretId: data.SecretId.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update skillsetability: %s", err))
+		return
+	}
+
+	nullUnknownStrings(&data.VerificationUrl, &data.UpdatedAt)
+
+	if err := r.refreshVerification(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secret: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success. A
+// provisioned secret is kept so that other abilities can continue to use it
+// without authorizing it again.
+func (r *SkillsetPlatformAbilityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SkillsetPlatformAbilityResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit GraphQL API to delete skillsetability
+
+	_, err := r.client.DeleteSkillsetAbility(ctx, data.SkillsetId.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete skillsetability: %s", err))
+		return
+	}
+}
+
+// ImportState imports the resource state from Terraform.
+func (r *SkillsetPlatformAbilityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID in the form skillset_id/ability_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("skillset_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+// provisionSecret returns the ID of a secret implementing the platform secret
+// template, creating the secret when no matching one exists.
+func (r *SkillsetPlatformAbilityResource) provisionSecret(ctx context.Context, platformSecretId string, blueprintId *string) (string, error) {
+	template, err := r.client.GetPlatformSecret(ctx, platformSecretId)
+	if err != nil {
+		return "", err
+	}
+
+	secrets, err := r.client.ListSecrets(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if existing := findPlatformSecret(secrets, template, blueprintId); existing != nil {
+		return *existing.ID, nil
+	}
+
+	result, err := r.client.CreateSecret(ctx, CreateSecretInput{
+		BlueprintId: blueprintId,
+		Config: template.Config,
+		Description: template.Description,
+		Kind: template.Kind,
+		Name: template.Name,
+		Type: template.Type,
+	})
+	if err != nil {
+		return "", err
+	}
+	if result.ID == nil {
+		return "", fmt.Errorf("creation of secret for platform secret %s returned no ID", platformSecretId)
+	}
+
+	return *result.ID, nil
+}
+
+// refreshVerification sets the verification URL from the current state of the
+// ability secret.
+func (r *SkillsetPlatformAbilityResource) refreshVerification(ctx context.Context, data *SkillsetPlatformAbilityResourceModel) error {
+	data.VerificationUrl = types.StringNull()
+
+	if data.SecretId.IsNull() || data.SecretId.IsUnknown() {
+		return nil
+	}
+
+	secret, err := r.client.GetSecret(ctx, data.SecretId.ValueString())
+	if err != nil {
+		// A secret deleted outside of Terraform has nothing to verify
+		if strings.Contains(err.Error(), "not found") {
+			return nil
+		}
+		return err
+	}
+
+	data.VerificationUrl = types.StringPointerValue(secretVerificationURL(secret))
+
+	return nil
+}
+
+// findPlatformSecret returns the secret implementing a platform secret
+// template, identified by the template name, kind and type. When blueprintId
+// is set, only secrets in that blueprint are considered. Otherwise secrets
+// outside of any blueprint are preferred, falling back to a secret in some
+// blueprint.
+func findPlatformSecret(secrets []*GetSecretResponse, template *PlatformSecretResponse, blueprintId *string) *GetSecretResponse {
+	var scoped *GetSecretResponse
+	for _, secret := range secrets {
+		if secret == nil || secret.ID == nil {
+			continue
+		}
+		if !equalStrings(secret.Name, template.Name) || !equalStrings(secret.Kind, template.Kind) || !equalStrings(secret.Type, template.Type) {
+			continue
+		}
+		if blueprintId != nil {
+			if equalStrings(secret.BlueprintId, blueprintId) {
+				return secret
+			}
+			continue
+		}
+		if secret.BlueprintId == nil || *secret.BlueprintId == "" {
+			return secret
+		}
+		if scoped == nil {
+			scoped = secret
+		}
+	}
+	return scoped
+}
+
+// secretVerificationURL returns the URL to visit to authorize a secret, or
+// nil when the secret does not require authorization.
+func secretVerificationURL(secret *GetSecretResponse) *string {
+	return verificationURL(secret.Verification)
+}
+
+// verificationURL returns the URL of a pending verification action, or nil
+// when no action is required.
+func verificationURL(v *SecretVerificationResponse) *string {
+	if v == nil || v.Action == nil || v.Action.URL == nil {
+		return nil
+	}
+	if v.Status != nil && *v.Status == secretVerifiedStatus {
+		return nil
+	}
+	return v.Action.URL
+}
+
+// equalStrings reports whether two optional strings are equal.
+func equalStrings(a *string, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
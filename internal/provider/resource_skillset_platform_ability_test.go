@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestFindPlatformSecret(t *testing.T) {
+	template := &PlatformSecretResponse{
+		ID:   ptr("google/calendar"),
+		Name: ptr("Google Calendar"),
+		Kind: ptr("personal"),
+		Type: ptr("oauth"),
+	}
+
+	secrets := []*GetSecretResponse{
+		{ID: ptr("secret_token"), Name: ptr("Google Calendar"), Kind: ptr("personal"), Type: ptr("token")},
+		{ID: ptr("secret_blueprint"), Name: ptr("Google Calendar"), Kind: ptr("personal"), Type: ptr("oauth"), BlueprintId: ptr("blueprint_1")},
+		{ID: ptr("secret_global"), Name: ptr("Google Calendar"), Kind: ptr("personal"), Type: ptr("oauth")},
+		nil,
+	}
+
+	t.Run("prefers a secret outside of any blueprint", func(t *testing.T) {
+		got := findPlatformSecret(secrets, template, nil)
+		if got == nil || *got.ID != "secret_global" {
+			t.Errorf("expected 'secret_global', got %v", got)
+		}
+	})
+
+	t.Run("falls back to a secret in a blueprint", func(t *testing.T) {
+		got := findPlatformSecret(secrets[:2], template, nil)
+		if got == nil || *got.ID != "secret_blueprint" {
+			t.Errorf("expected 'secret_blueprint', got %v", got)
+		}
+	})
+
+	t.Run("reuses the secret in the blueprint", func(t *testing.T) {
+		got := findPlatformSecret(secrets, template, ptr("blueprint_1"))
+		if got == nil || *got.ID != "secret_blueprint" {
+			t.Errorf("expected 'secret_blueprint', got %v", got)
+		}
+	})
+
+	t.Run("only considers secrets in the blueprint", func(t *testing.T) {
+		got := findPlatformSecret(secrets, template, ptr("blueprint_2"))
+		if got != nil {
+			t.Errorf("expected no secret, got %v", *got.ID)
+		}
+	})
+
+	t.Run("returns nil without a match", func(t *testing.T) {
+		got := findPlatformSecret(secrets[:1], template, nil)
+		if got != nil {
+			t.Errorf("expected no secret, got %v", *got.ID)
+		}
+	})
+}
+
+func TestSecretVerificationURL(t *testing.T) {
+	pending := &GetSecretResponse{Verification: &SecretVerificationResponse{
+		Status: ptr("pending"),
+		Action: &SecretVerificationActionResponse{URL: ptr("https://example.com/authorize")},
+	}}
+
+	if got := secretVerificationURL(pending); got == nil || *got != "https://example.com/authorize" {
+		t.Errorf("expected verification URL, got %v", got)
+	}
+
+	pending.Verification.Status = ptr(secretVerifiedStatus)
+	if got := secretVerificationURL(pending); got != nil {
+		t.Errorf("expected no URL for verified secret, got %v", *got)
+	}
+
+	if got := secretVerificationURL(&GetSecretResponse{}); got != nil {
+		t.Errorf("expected no URL without verification, got %v", *got)
+	}
+}
@@ -122,6 +122,7 @@ func (r *SlackIntegrationResource) Schema(ctx context.Context, req resource.Sche
 			"user_token": schema.StringAttribute{
 				MarkdownDescription: "The Slack user token for additional permissions",
 				Optional:            true,
+				Sensitive:           true,
 			},
 			"visible_messages": schema.Int64Attribute{
 				MarkdownDescription: "The number of visible messages in the conversation",
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSpaceResource(t *testing.T) {
+	server, calls := newRESTServer(t, func(r *http.Request) (int, interface{}) {
+		switch r.URL.Path {
+		case "/v1/space/space_missing/fetch":
+			return http.StatusNotFound, map[string]interface{}{"message": "Not Found"}
+		case "/v1/space/space_123/fetch":
+			return http.StatusOK, map[string]interface{}{
+				"id":        "space_123",
+				"name":      "Support",
+				"contactId": "contact_123",
+				"meta":      map[string]interface{}{"team": "support"},
+				"createdAt": "2025-01-01T00:00:00Z",
+				"updatedAt": "2025-01-02T00:00:00Z",
+			}
+		}
+		return http.StatusOK, map[string]interface{}{"id": "space_123"}
+	})
+
+	r := &SpaceResource{client: NewClient("test-api-key", server.URL)}
+	ctx := context.Background()
+
+	model := SpaceResourceModel{
+		ID:        types.StringUnknown(),
+		Name:      types.StringValue("Support"),
+		ContactId: types.StringValue("contact_123"),
+		Meta:      types.MapNull(types.StringType),
+		CreatedAt: types.StringUnknown(),
+		UpdatedAt: types.StringUnknown(),
+	}
+	plan := testResourceState(t, r, &model)
+
+	t.Run("create", func(t *testing.T) {
+		resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+		r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("expected no error, got %v", resp.Diagnostics)
+		}
+
+		var got SpaceResourceModel
+		resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+		if got.ID.ValueString() != "space_123" {
+			t.Errorf("expected ID 'space_123', got '%s'", got.ID.ValueString())
+		}
+		if !got.CreatedAt.IsNull() {
+			t.Errorf("expected created_at to be null until refreshed, got %v", got.CreatedAt)
+		}
+	})
+
+	model.ID = types.StringValue("space_123")
+	model.CreatedAt = types.StringNull()
+	model.UpdatedAt = types.StringNull()
+	state := testResourceState(t, r, &model)
+
+	t.Run("read", func(t *testing.T) {
+		resp := &resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("expected no error, got %v", resp.Diagnostics)
+		}
+
+		var got SpaceResourceModel
+		resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+		if got.CreatedAt.ValueString() != "2025-01-01T00:00:00Z" {
+			t.Errorf("expected created_at from the API, got '%s'", got.CreatedAt.ValueString())
+		}
+		if got.Meta.Elements()["team"] != types.StringValue("support") {
+			t.Errorf("expected meta from the API, got %v", got.Meta)
+		}
+	})
+
+	t.Run("read removes a deleted space", func(t *testing.T) {
+		missing := model
+		missing.ID = types.StringValue("space_missing")
+		missingState := testResourceState(t, r, &missing)
+
+		resp := &resource.ReadResponse{State: missingState}
+		r.Read(ctx, resource.ReadRequest{State: missingState}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("expected no error, got %v", resp.Diagnostics)
+		}
+		if !resp.State.Raw.IsNull() {
+			t.Error("expected the space to be removed from state")
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		resp := &resource.UpdateResponse{State: state}
+		r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}, State: state}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("expected no error, got %v", resp.Diagnostics)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		resp := &resource.DeleteResponse{State: state}
+		r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("expected no error, got %v", resp.Diagnostics)
+		}
+	})
+
+	expected := []struct{ method, path, field string }{
+		{"POST", "/v1/space/create", "contactId"},
+		{"GET", "/v1/space/space_123/fetch", ""},
+		{"GET", "/v1/space/space_missing/fetch", ""},
+		{"POST", "/v1/space/space_123/update", "name"},
+		{"POST", "/v1/space/space_123/delete", ""},
+	}
+	assertRESTCalls(t, *calls, expected)
+}
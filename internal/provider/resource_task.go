@@ -0,0 +1,387 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &TaskResource{}
+	_ resource.ResourceWithImportState = &TaskResource{}
+)
+
+// taskSchedules lists the schedules a task can run on.
+var taskSchedules = []string{
+	"never",
+	"quarterhourly",
+	"halfhourly",
+	"hourly",
+	"daily",
+	"weekly",
+	"monthly",
+}
+
+func NewTaskResource() resource.Resource {
+	return &TaskResource{}
+}
+
+// TaskResource defines the resource implementation.
+type TaskResource struct {
+	client *Client
+}
+
+// TaskResourceModel describes the resource data model.
+type TaskResourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	BlueprintId types.String `tfsdk:"blueprint_id"`
+	BotId types.String `tfsdk:"bot_id"`
+	ContactId types.String `tfsdk:"contact_id"`
+	Description types.String `tfsdk:"description"`
+	Meta types.Map `tfsdk:"meta"`
+	Name types.String `tfsdk:"name"`
+	RunOnApply types.Bool `tfsdk:"run_on_apply"`
+	Schedule types.String `tfsdk:"schedule"`
+	SessionDuration types.Int64 `tfsdk:"session_duration"`
+	Status types.String `tfsdk:"status"`
+	Outcome types.String `tfsdk:"outcome"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *TaskResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_task"
+}
+
+// Schema defines the schema for the resource.
+func (r *TaskResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Input parameters for creating a new task",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the task",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"blueprint_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the blueprint to use",
+				Optional:            true,
+			},
+			"bot_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the bot that runs the task",
+				Optional:            true,
+			},
+			"contact_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the contact the task runs on behalf of",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the task, used as the instruction for the bot",
+				Optional:            true,
+			},
+			"meta": schema.MapAttribute{
+				MarkdownDescription: "Additional metadata for the task",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the task",
+				Optional:            true,
+			},
+			"run_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Whether to trigger one execution of the task every time Terraform creates it or applies an in-place change to any of its arguments. Applies without changes to the task do not run it",
+				Optional:            true,
+			},
+			"schedule": schema.StringAttribute{
+				MarkdownDescription: "The schedule for automatic task execution",
+				Optional:            true,
+				Validators: []validator.String{
+					stringOneOf(taskSchedules...),
+				},
+			},
+			"session_duration": schema.Int64Attribute{
+				MarkdownDescription: "The duration of the session in milliseconds",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The current status of the task (idle or running)",
+				Computed:            true,
+			},
+			"outcome": schema.StringAttribute{
+				MarkdownDescription: "The outcome of the last task execution (pending, success or failure)",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was created",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the resource was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *TaskResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *TaskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TaskResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit API to create task
+
+	result, err := r.client.CreateTask(ctx, CreateTaskInput{
+		BlueprintId: data.BlueprintId.ValueStringPointer(),
+		BotId: data.BotId.ValueStringPointer(),
+		ContactId: data.ContactId.ValueStringPointer(),
+		Description: data.Description.ValueStringPointer(),
+		Meta: convertMapToInterface(ctx, data.Meta),
+		Name: data.Name.ValueStringPointer(),
+		Schedule: data.Schedule.ValueStringPointer(),
+		SessionDuration: taskSessionDuration(data.SessionDuration),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create task: %s", err))
+		return
+	}
+
+	// Set the ID from the response
+	if result.ID != nil {
+		data.ID = types.StringPointerValue(result.ID)
+	}
+
+	nullUnknownStrings(&data.Status, &data.Outcome, &data.CreatedAt, &data.UpdatedAt)
+
+	// Save data into Terraform state before running the task so that a
+	// failed execution does not orphan the task
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RunOnApply.ValueBool() {
+		if _, err := r.client.ExecuteTask(ctx, data.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to execute task: %s", err))
+			return
+		}
+	}
+
+	// Read the task back so that status and outcome are known after the
+	// first apply rather than only after the next refresh
+	resp.Diagnostics.Append(r.refreshStatus(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *TaskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TaskResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit API to read task
+
+	result, err := r.client.GetTask(ctx, data.ID.ValueString())
+	if err != nil {
+		// Check if resource was deleted outside of Terraform
+		if strings.Contains(err.Error(), "not found") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read task: %s", err))
+		return
+	}
+
+	// Update data model with response values
+
+	if result.BlueprintId != nil {
+		data.BlueprintId = types.StringPointerValue(result.BlueprintId)
+	}
+	if result.BotId != nil {
+		data.BotId = types.StringPointerValue(result.BotId)
+	}
+	if result.ContactId != nil {
+		data.ContactId = types.StringPointerValue(result.ContactId)
+	}
+	if result.Description != nil {
+		data.Description = types.StringPointerValue(result.Description)
+	}
+	if result.Meta != nil {
+		mapValue, diags := types.MapValueFrom(ctx, types.StringType, result.Meta)
+		resp.Diagnostics.Append(diags...)
+		data.Meta = mapValue
+	}
+	if result.Name != nil {
+		data.Name = types.StringPointerValue(result.Name)
+	}
+	if result.Schedule != nil {
+		data.Schedule = types.StringPointerValue(result.Schedule)
+	}
+	if result.SessionDuration != nil {
+		data.SessionDuration = types.Int64Value(int64(*result.SessionDuration))
+	}
+	data.Status = types.StringPointerValue(result.Status)
+	data.Outcome = types.StringPointerValue(result.Outcome)
+	if result.CreatedAt != nil {
+		data.CreatedAt = types.StringPointerValue(result.CreatedAt)
+	}
+	if result.UpdatedAt != nil {
+		data.UpdatedAt = types.StringPointerValue(result.UpdatedAt)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *TaskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TaskResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit API to update task
+
+	_, err := r.client.UpdateTask(ctx, data.ID.ValueString(), UpdateTaskInput{
+		BlueprintId: data.BlueprintId.ValueStringPointer(),
+		BotId: data.BotId.ValueStringPointer(),
+		ContactId: data.ContactId.ValueStringPointer(),
+		Description: data.Description.ValueStringPointer(),
+		Meta: convertMapToInterface(ctx, data.Meta),
+		Name: data.Name.ValueStringPointer(),
+		Schedule: data.Schedule.ValueStringPointer(),
+		SessionDuration: taskSessionDuration(data.SessionDuration),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update task: %s", err))
+		return
+	}
+
+	nullUnknownStrings(&data.Status, &data.Outcome, &data.CreatedAt, &data.UpdatedAt)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RunOnApply.ValueBool() {
+		if _, err := r.client.ExecuteTask(ctx, data.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to execute task: %s", err))
+			return
+		}
+	}
+
+	// Read the task back so that status and outcome are known after the
+	// first apply rather than only after the next refresh
+	resp.Diagnostics.Append(r.refreshStatus(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// refreshStatus reads the task back and updates the computed status, outcome
+// and timestamps. Failures are reported as warnings because the task itself
+// has already been saved; the next refresh fills the values in.
+func (r *TaskResource) refreshStatus(ctx context.Context, data *TaskResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	result, err := r.client.GetTask(ctx, data.ID.ValueString())
+	if err != nil {
+		diags.AddWarning("Task Status Unavailable", fmt.Sprintf("Unable to read task status: %s", err))
+		return diags
+	}
+
+	data.Status = types.StringPointerValue(result.Status)
+	data.Outcome = types.StringPointerValue(result.Outcome)
+	if result.CreatedAt != nil {
+		data.CreatedAt = types.StringPointerValue(result.CreatedAt)
+	}
+	if result.UpdatedAt != nil {
+		data.UpdatedAt = types.StringPointerValue(result.UpdatedAt)
+	}
+
+	return diags
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *TaskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TaskResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call the ChatBotKit API to delete task
+
+	_, err := r.client.DeleteTask(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete task: %s", err))
+		return
+	}
+}
+
+// ImportState imports the resource state from Terraform.
+func (r *TaskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// taskSessionDuration converts the configured session duration to the
+// floating point value the API expects.
+func taskSessionDuration(v types.Int64) *float64 {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	duration := float64(v.ValueInt64())
+	return &duration
+}
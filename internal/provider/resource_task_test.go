@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// newTaskServer serves the task REST endpoints, reporting the task as
+// running with a pending outcome.
+func newTaskServer(t *testing.T) (*Client, *[]restCall) {
+	t.Helper()
+
+	server, calls := newRESTServer(t, func(r *http.Request) (int, interface{}) {
+		if r.URL.Path == "/v1/task/task_123/fetch" {
+			return http.StatusOK, map[string]interface{}{
+				"id":        "task_123",
+				"status":    "running",
+				"outcome":   "pending",
+				"createdAt": "2025-01-01T00:00:00Z",
+				"updatedAt": "2025-01-02T00:00:00Z",
+			}
+		}
+		return http.StatusOK, map[string]interface{}{"id": "task_123"}
+	})
+
+	return NewClient("test-api-key", server.URL), calls
+}
+
+func testTaskModel(id types.String, runOnApply bool) *TaskResourceModel {
+	return &TaskResourceModel{
+		ID:              id,
+		Name:            types.StringValue("Daily Digest"),
+		Meta:            types.MapNull(types.StringType),
+		RunOnApply:      types.BoolValue(runOnApply),
+		SessionDuration: types.Int64Null(),
+		Status:          types.StringUnknown(),
+		Outcome:         types.StringUnknown(),
+		CreatedAt:       types.StringUnknown(),
+		UpdatedAt:       types.StringUnknown(),
+	}
+}
+
+func TestTaskRunOnApply(t *testing.T) {
+	ctx := context.Background()
+
+	for _, runOnApply := range []bool{true, false} {
+		t.Run(fmt.Sprintf("create with run_on_apply %v", runOnApply), func(t *testing.T) {
+			client, calls := newTaskServer(t)
+			r := &TaskResource{client: client}
+
+			plan := testResourceState(t, r, testTaskModel(types.StringUnknown(), runOnApply))
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+			r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("expected no error, got %v", resp.Diagnostics)
+			}
+
+			expected := []struct{ method, path, field string }{
+				{"POST", "/v1/task/create", "name"},
+				{"POST", "/v1/task/task_123/trigger", ""},
+				{"GET", "/v1/task/task_123/fetch", ""},
+			}
+			if !runOnApply {
+				expected = append(expected[:1], expected[2])
+			}
+			assertRESTCalls(t, *calls, expected)
+		})
+
+		t.Run(fmt.Sprintf("update with run_on_apply %v", runOnApply), func(t *testing.T) {
+			client, calls := newTaskServer(t)
+			r := &TaskResource{client: client}
+
+			state := testResourceState(t, r, testTaskModel(types.StringValue("task_123"), runOnApply))
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}, State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("expected no error, got %v", resp.Diagnostics)
+			}
+
+			expected := []struct{ method, path, field string }{
+				{"POST", "/v1/task/task_123/update", "name"},
+				{"POST", "/v1/task/task_123/trigger", ""},
+				{"GET", "/v1/task/task_123/fetch", ""},
+			}
+			if !runOnApply {
+				expected = append(expected[:1], expected[2])
+			}
+			assertRESTCalls(t, *calls, expected)
+
+			var got TaskResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			if got.Status.ValueString() != "running" {
+				t.Errorf("expected status 'running' after apply, got '%s'", got.Status.ValueString())
+			}
+		})
+	}
+}
+
+func TestTaskRefreshStatus(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("fills status and outcome", func(t *testing.T) {
+		client, _ := newTaskServer(t)
+		r := &TaskResource{client: client}
+
+		data := testTaskModel(types.StringValue("task_123"), false)
+		diags := r.refreshStatus(ctx, data)
+
+		if diags.HasError() || diags.WarningsCount() != 0 {
+			t.Fatalf("expected no diagnostics, got %v", diags)
+		}
+		if data.Status.ValueString() != "running" || data.Outcome.ValueString() != "pending" {
+			t.Errorf("expected status 'running' and outcome 'pending', got '%s' and '%s'", data.Status.ValueString(), data.Outcome.ValueString())
+		}
+		if data.UpdatedAt.ValueString() != "2025-01-02T00:00:00Z" {
+			t.Errorf("expected updated_at from the API, got '%s'", data.UpdatedAt.ValueString())
+		}
+	})
+
+	t.Run("warns when the task cannot be read", func(t *testing.T) {
+		server, _ := newRESTServer(t, func(r *http.Request) (int, interface{}) {
+			return http.StatusInternalServerError, map[string]interface{}{"message": "unavailable"}
+		})
+		r := &TaskResource{client: NewClient("test-api-key", server.URL)}
+
+		data := testTaskModel(types.StringValue("task_123"), false)
+		data.Status = types.StringNull()
+		diags := r.refreshStatus(ctx, data)
+
+		if diags.HasError() {
+			t.Fatalf("expected only a warning, got %v", diags)
+		}
+		if diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != "Task Status Unavailable" {
+			t.Errorf("expected a 'Task Status Unavailable' warning, got %v", diags)
+		}
+		if !data.Status.IsNull() {
+			t.Errorf("expected status to be left unset, got %v", data.Status)
+		}
+	})
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure validators fully satisfy framework interfaces.
+var (
+	_ validator.String = stringOneOfValidator{}
+	_ validator.Int64  = int64AtLeastValidator{}
+)
+
+// stringOneOfValidator checks that a string attribute is one of a fixed set
+// of values.
+type stringOneOfValidator struct {
+	values []string
+}
+
+// stringOneOf returns a validator which ensures that a configured string is
+// one of the given values. Null and unknown values are not checked.
+func stringOneOf(values ...string) validator.String {
+	return stringOneOfValidator{values: values}
+}
+
+// Description describes the validation in plain text formatting.
+func (v stringOneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", strings.Join(v.values, ", "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v stringOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	quoted := make([]string, len(v.values))
+	for i, value := range v.values {
+		quoted[i] = "`" + value + "`"
+	}
+	return fmt.Sprintf("value must be one of: %s", strings.Join(quoted, ", "))
+}
+
+// ValidateString performs the validation.
+func (v stringOneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	for _, allowed := range v.values {
+		if value == allowed {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value",
+		fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), value),
+	)
+}
+
+// int64AtLeastValidator checks that an integer attribute is not below a
+// minimum value.
+type int64AtLeastValidator struct {
+	min int64
+}
+
+// int64AtLeast returns a validator which ensures that a configured integer is
+// at least min. Null and unknown values are not checked.
+func int64AtLeast(min int64) validator.Int64 {
+	return int64AtLeastValidator{min: min}
+}
+
+// Description describes the validation in plain text formatting.
+func (v int64AtLeastValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be at least %d", v.min)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v int64AtLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("value must be at least `%d`", v.min)
+}
+
+// ValidateInt64 performs the validation.
+func (v int64AtLeastValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if value := req.ConfigValue.ValueInt64(); value < v.min {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %d", req.Path, v.Description(ctx), value),
+		)
+	}
+}
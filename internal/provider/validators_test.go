@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestStringOneOf(t *testing.T) {
+	tests := map[string]struct {
+		value     types.String
+		expectErr bool
+	}{
+		"allowed value": {
+			value: types.StringValue("daily"),
+		},
+		"disallowed value": {
+			value:     types.StringValue("yearly"),
+			expectErr: true,
+		},
+		"null value": {
+			value: types.StringNull(),
+		},
+		"unknown value": {
+			value: types.StringUnknown(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("schedule"),
+				ConfigValue: test.value,
+			}
+			resp := &validator.StringResponse{}
+
+			stringOneOf("daily", "weekly").ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != test.expectErr {
+				t.Errorf("expected error %t, got diagnostics: %v", test.expectErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestInt64AtLeast(t *testing.T) {
+	tests := map[string]struct {
+		value     types.Int64
+		expectErr bool
+	}{
+		"value above minimum": {
+			value: types.Int64Value(600),
+		},
+		"value at minimum": {
+			value: types.Int64Value(1),
+		},
+		"zero": {
+			value:     types.Int64Value(0),
+			expectErr: true,
+		},
+		"negative value": {
+			value:     types.Int64Value(-5),
+			expectErr: true,
+		},
+		"null value": {
+			value: types.Int64Null(),
+		},
+		"unknown value": {
+			value: types.Int64Unknown(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := validator.Int64Request{
+				Path:        path.Root("verification_timeout"),
+				ConfigValue: test.value,
+			}
+			resp := &validator.Int64Response{}
+
+			int64AtLeast(1).ValidateInt64(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != test.expectErr {
+				t.Errorf("expected error %t, got diagnostics: %v", test.expectErr, resp.Diagnostics)
+			}
+		})
+	}
+}